@@ -0,0 +1,65 @@
+package validate
+
+import "strings"
+
+// Default values for the Boolean() validators; these are compared
+// case-insensitive.
+var (
+	DefaultTrueValues  = []string{"1", "y", "yes", "t", "true"}
+	DefaultFalseValues = []string{"0", "n", "no", "f", "false"}
+)
+
+// BooleanOptions are the options for the BooleanWithOptions() and
+// OptionalBoolean() validators.
+type BooleanOptions struct {
+	// Values that are true or false, compared case-insensitive; nil means
+	// DefaultTrueValues and DefaultFalseValues. For example, use
+	// []string{"on"} and []string{"off"} for HTML checkboxes without a value
+	// attribute.
+	True, False []string
+
+	// Remove leading and trailing whitespace from the value.
+	TrimSpace bool
+}
+
+// BooleanWithOptions checks if this looks like a boolean value, with the
+// options.
+//
+// Empty values are false; use OptionalBoolean() to tell them apart from
+// false values.
+func (v *Validator) BooleanWithOptions(key, value string, opts BooleanOptions, message ...string) bool {
+	b := v.OptionalBoolean(key, value, opts, message...)
+	return b != nil && *b
+}
+
+// OptionalBoolean checks if this looks like a boolean value, with the options.
+//
+// The parsed value is returned, or nil if the value is empty or invalid.
+func (v *Validator) OptionalBoolean(key, value string, opts BooleanOptions, message ...string) *bool {
+	if opts.TrimSpace {
+		value = strings.TrimSpace(value)
+	}
+	if value == "" {
+		return nil
+	}
+
+	t, f := opts.True, opts.False
+	if t == nil {
+		t = DefaultTrueValues
+	}
+	if f == nil {
+		f = DefaultFalseValues
+	}
+
+	var b bool
+	switch {
+	case includeString(t, value):
+		b = true
+	case includeString(f, value):
+		b = false
+	default:
+		v.Append(key, getMessage(message, MessageBool))
+		return nil
+	}
+	return &b
+}
@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestOptionalBoolean(t *testing.T) {
+	var (
+		tru = func() *bool { b := true; return &b }()
+		fls = func() *bool { b := false; return &b }()
+	)
+	checkbox := BooleanOptions{True: []string{"on"}, False: []string{"off"}}
+
+	tests := []struct {
+		in      string
+		opts    BooleanOptions
+		want    *bool
+		wantErr []string
+	}{
+		{"", BooleanOptions{}, nil, nil},
+		{"true", BooleanOptions{}, tru, nil},
+		{"YES", BooleanOptions{}, tru, nil},
+		{"0", BooleanOptions{}, fls, nil},
+		{"F", BooleanOptions{}, fls, nil},
+		{"on", BooleanOptions{}, nil, []string{MessageBool}},
+		{" true ", BooleanOptions{}, nil, []string{MessageBool}},
+
+		{" true ", BooleanOptions{TrimSpace: true}, tru, nil},
+		{"  ", BooleanOptions{TrimSpace: true}, nil, nil},
+
+		{"on", checkbox, tru, nil},
+		{"ON", checkbox, tru, nil},
+		{"off", checkbox, fls, nil},
+		{"true", checkbox, nil, []string{MessageBool}},
+
+		// Only one set replaced.
+		{"on", BooleanOptions{True: []string{"on"}}, tru, nil},
+		{"no", BooleanOptions{True: []string{"on"}}, fls, nil},
+		{"yes", BooleanOptions{True: []string{"on"}}, nil, []string{MessageBool}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			out := v.OptionalBoolean("k", tt.in, tt.opts)
+			if !reflect.DeepEqual(out, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["k"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["k"], tt.wantErr)
+			}
+
+			v = New()
+			b := v.BooleanWithOptions("k", tt.in, tt.opts, "oops")
+			if b != (tt.want != nil && *tt.want) {
+				t.Errorf("BooleanWithOptions\nout:  %#v\nwant: %#v\n", b, tt.want)
+			}
+			if (len(v.Errors["k"]) > 0) != (tt.wantErr != nil) || (tt.wantErr != nil && v.Errors["k"][0] != "oops") {
+				t.Errorf("BooleanWithOptions errors\nout:  %#v\n", v.Errors["k"])
+			}
+		})
+	}
+}
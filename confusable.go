@@ -0,0 +1,255 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Confusable characters from the Unicode Security Mechanisms (TR39)
+// confusables data, mapped to the prototype they look like.
+//
+// This is a subset of the full data which covers characters that look like
+// ASCII letters and digits, which are the most common in homograph attacks.
+// Fullwidth ASCII (U+FF01 to U+FF5E) is mapped in Skeleton().
+var confusables = map[rune]string{
+	// ASCII
+	'0': "O", '1': "l", 'I': "l", '|': "l", 'm': "rn",
+
+	// Latin
+	'ı': "i", 'ɑ': "a", 'ɡ': "g", 'ɩ': "i", 'ʟ': "L", 'ǀ': "l", 'ȷ': "j",
+	'ℓ': "l", 'ⅰ': "i", 'ⅼ': "l", 'ⅽ': "c", 'ⅾ': "d", 'ⅿ': "rn", 'ꞵ': "B",
+
+	// Cyrillic
+	'а': "a", 'в': "B", 'е': "e", 'о': "o", 'р': "p", 'с': "c", 'у': "y",
+	'х': "x", 'і': "i", 'ј': "j", 'ѕ': "s", 'һ': "h", 'ԁ': "d", 'ԛ': "q",
+	'ԝ': "w", 'ӏ': "l", 'ы': "bl", 'ь': "b", 'г': "r", 'п': "n", 'ѵ': "v",
+	'А': "A", 'В': "B", 'Е': "E", 'К': "K", 'М': "M", 'Н': "H", 'О': "O",
+	'Р': "P", 'С': "C", 'Т': "T", 'Х': "X", 'У': "Y", 'І': "l", 'Ј': "J",
+	'Ѕ': "S", 'Ԛ': "Q", 'Ԝ': "W", 'Ӏ': "l", 'З': "3", 'Ь': "b",
+
+	// Greek
+	'α': "a", 'ο': "o", 'ρ': "p", 'ν': "v", 'υ': "u", 'ι': "i", 'γ': "y",
+	'Α': "A", 'Β': "B", 'Ε': "E", 'Ζ': "Z", 'Η': "H", 'Ι': "l", 'Κ': "K",
+	'Μ': "M", 'Ν': "N", 'Ο': "O", 'Ρ': "P", 'Τ': "T", 'Υ': "Y", 'Χ': "X",
+
+	// Armenian
+	'օ': "o", 'ս': "u", 'հ': "h", 'ո': "n", 'ց': "g", 'զ': "q",
+}
+
+// Skeleton gets the "skeleton" of a string as described in Unicode TR39: two
+// strings with the same skeleton look confusingly similar, such as "paypal"
+// and "pаypal" (with a Cyrillic "а"), or "google" and "g00gle".
+//
+// As in TR39 the characters are mapped as-is, so an uppercase "I" looks like
+// a lowercase "l" and "paypaI" has the same skeleton as "paypal". Unlike TR39
+// the skeleton is case-folded after that, so that "TEAMWORK" and "teamwork"
+// have the same skeleton. Confusable() also compares the lowercase value, so
+// "MICROSOFT" looks like "microsoft" even though their skeletons differ.
+//
+// The confusables data is limited to characters that look like ASCII letters
+// and digits.
+func Skeleton(s string) string {
+	// Letters can become a confusable after case-folding, such as "M" to
+	// "m", so map again.
+	return norm.NFD.String(mapConfusables(strings.ToLower(mapConfusables(norm.NFD.String(s)))))
+}
+
+func mapConfusables(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= 0xff01 && r <= 0xff5e {
+			r -= 0xfee0
+		}
+		if c, ok := confusables[r]; ok {
+			b.WriteString(c)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// Scripts which may be mixed with each other, as in the "highly restrictive"
+// level of TR39: Latin can be used with Japanese, Chinese, and Korean.
+var allowedScriptSets = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+// scriptNames is a sorted list of all script names, so the lookup is
+// deterministic.
+var scriptNames = func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for n := range unicode.Scripts {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// script gets the name of the script of the rune, or "" for the Common and
+// Inherited scripts (i.e. punctuation, digits, and combining marks).
+func script(r rune) string {
+	if r < 0x80 {
+		if unicode.IsLetter(r) {
+			return "Latin"
+		}
+		return ""
+	}
+	for _, n := range scriptNames {
+		if unicode.Is(unicode.Scripts[n], r) {
+			if n == "Common" || n == "Inherited" {
+				return ""
+			}
+			return n
+		}
+	}
+	return ""
+}
+
+// MixedScript gets all characters that are in a different script than the
+// rest of the word they're in, such as the Cyrillic "а" in "pаypal".
+//
+// Words are split on characters from the Common and Inherited scripts, such as
+// spaces, punctuation, and digits, so "Иван Smith" and "пример.com" are not
+// mixed script. Latin may be mixed with Chinese, Japanese, and Korean, as in
+// the "highly restrictive" level of TR39.
+func MixedScript(s string) []rune {
+	var (
+		suspicious []rune
+		word       []rune
+	)
+	check := func() {
+		suspicious = append(suspicious, mixedScriptWord(word)...)
+		word = word[:0]
+	}
+	for _, r := range s {
+		if script(r) == "" && !unicode.IsMark(r) {
+			check()
+			continue
+		}
+		word = append(word, r)
+	}
+	check()
+	return suspicious
+}
+
+func mixedScriptWord(word []rune) []rune {
+	count := make(map[string]int)
+	for _, r := range word {
+		if sc := script(r); sc != "" {
+			count[sc]++
+		}
+	}
+	if len(count) < 2 {
+		return nil
+	}
+
+NEXT:
+	for _, set := range allowedScriptSets {
+		for sc := range count {
+			if !includeString(set, sc) {
+				continue NEXT
+			}
+		}
+		return nil
+	}
+
+	// Characters not in the most common script are suspicious; prefer Latin
+	// on a tie since that's what attacks usually target.
+	main := ""
+	for _, sc := range scriptNames {
+		if count[sc] > count[main] || (count[sc] == count[main] && sc == "Latin") {
+			main = sc
+		}
+	}
+	var suspicious []rune
+	for _, r := range word {
+		if sc := script(r); sc != "" && sc != main {
+			suspicious = append(suspicious, r)
+		}
+	}
+	return suspicious
+}
+
+// ConfusableOptions are the options for the Confusable() validator.
+type ConfusableOptions struct {
+	// Reject values which look confusingly similar to any of these, but aren't
+	// the same; e.g. "teamwork.com" to reject "teamw0rk.com" and
+	// "tеamwork.com" (with a Cyrillic "е").
+	Protected []string
+
+	// Allow mixing scripts within a word.
+	AllowMixedScript bool
+}
+
+// Confusable validates that the value doesn't contain characters that could be
+// used to impersonate another value, such as "pаypal.com" with a Cyrillic "а".
+//
+// This can be used for domains, email addresses, usernames, and display names.
+// Words that mix scripts are rejected (see MixedScript()), as are values that
+// look like one of the protected values (see Skeleton()).
+//
+// The suspicious characters are returned, and listed in the error.
+func (v *Validator) Confusable(key, value string, opts ConfusableOptions, message ...string) []rune {
+	if value == "" {
+		return nil
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+	}
+
+	var suspicious []rune
+	if !opts.AllowMixedScript {
+		suspicious = MixedScript(value)
+		if len(suspicious) > 0 {
+			appendMsg(fmt.Sprintf(MessageMixedScript, formatRunes(suspicious)))
+		}
+	}
+
+	skel, lower := Skeleton(value), Skeleton(strings.ToLower(value))
+	for _, p := range opts.Protected {
+		ps := Skeleton(p)
+		if (skel != ps && lower != ps) || strings.EqualFold(norm.NFC.String(value), norm.NFC.String(p)) {
+			continue
+		}
+
+		// Confusable letters are common, so only report the ones that look
+		// like another letter because of their case ("I" in "paypaI").
+		if len(suspicious) == 0 {
+			for _, r := range value {
+				if _, ok := confusables[r]; (ok && (!unicode.IsLetter(r) || (unicode.IsUpper(r) && lower != ps))) || r > unicode.MaxASCII {
+					suspicious = append(suspicious, r)
+				}
+			}
+		}
+		appendMsg(fmt.Sprintf(MessageConfusable, p))
+		break
+	}
+
+	return suspicious
+}
+
+// formatRunes formats runes as "‘а’ (U+0430), ‘ο’ (U+03BF)".
+func formatRunes(runes []rune) string {
+	seen := make(map[rune]bool)
+	var s []string
+	for _, r := range runes {
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+		s = append(s, fmt.Sprintf("‘%c’ (%U)", r, r))
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,143 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"paypal", "paypal", true},
+		{"paypal", "PayPal", true},
+		{"teamwork.com", "TEAMWORK.COM", true},
+		{"teamwork.com", "TEAMW0RK.COM", true},
+		{"MICROSOFT", "MICROS0FT", true},
+		{"microsoft", "MіcrоSoft", true},  // Cyrillic і and о
+		{"MICROSOFT", "МІСRОЅОFТ", true},  // Cyrillic uppercase
+		{"MICROSOFT", "ΜΙCROSOFT", true},  // Greek uppercase
+		{"microsoft", "MICROSOFT", false}, // Uppercase I looks like l
+		{"paypal", "paypaI", true},
+		{"paypal", "PAYPAI", true},
+		{"nope", "ΝΟΡΕ", true},         // Greek uppercase
+		{"nope", "νope", false},        // Greek ν looks like v
+		{"PAYPAL", "раураӏ", true},     // Cyrillic lowercase
+		{"teamwork", "ＴＥＡＭＷＯＲＫ", true}, // Fullwidth uppercase
+		{"paypal", "pаypal", true},     // Cyrillic а
+		{"paypal", "pαypal", true},     // Greek α
+		{"google", "g00gle", true},     // Digits
+		{"google", "gοοgle", true},     // Greek ο
+		{"apple", "аррӏе", true},       // All Cyrillic
+		{"microsoft", "rnicrosoft", true},
+		{"teamwork", "ｔｅａｍｗｏｒｋ", true}, // Fullwidth
+		{"café", "café", true},        // Normalization
+		{"paypal", "paypa1", true},
+		{"paypal", "paypai", false},
+		{"café", "cafe", false},
+		{"google", "goggle", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.b, func(t *testing.T) {
+			if out := Skeleton(tt.a) == Skeleton(tt.b); out != tt.equal {
+				t.Errorf("%q (%q) == %q (%q): %t", tt.a, Skeleton(tt.a), tt.b, Skeleton(tt.b), out)
+			}
+		})
+	}
+}
+
+func TestMixedScript(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []rune
+	}{
+		{"", nil},
+		{"paypal.com", nil},
+		{"пример.рф", nil},
+		{"пример.com", nil},
+		{"Иван Smith", nil},
+		{"東京タワー.jp", nil},
+		{"日本語とLatin", nil},
+		{"한국어abc", nil},
+		{"café", nil},
+		{"user123", nil},
+		{"pаypal.com", []rune{'а'}},
+		{"аpple", []rune{'а'}},
+		{"Jоhn Smith", []rune{'о'}},
+		{"ѕеrgеy", []rune{'ѕ', 'е', 'е'}},
+		{"aβc.example", []rune{'β'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if out := MixedScript(tt.in); !reflect.DeepEqual(out, tt.want) {
+				t.Errorf("\nout:  %q\nwant: %q\n", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfusable(t *testing.T) {
+	protected := ConfusableOptions{Protected: []string{"teamwork.com", "paypal"}}
+
+	tests := []struct {
+		in         string
+		opts       ConfusableOptions
+		want       []rune
+		wantErrors map[string][]string
+	}{
+		{"", ConfusableOptions{}, nil, map[string][]string{}},
+		{"example.com", ConfusableOptions{}, nil, map[string][]string{}},
+		{"teamwork.com", protected, nil, map[string][]string{}},
+		{"TeamWork.com", protected, nil, map[string][]string{}},
+		{"pаypal.com", ConfusableOptions{}, []rune{'а'}, map[string][]string{
+			"k": {"cannot mix characters from different scripts (‘а’ (U+0430))"},
+		}},
+		{"pаypal", ConfusableOptions{AllowMixedScript: true}, nil, map[string][]string{}},
+		{"pаypal", protected, []rune{'а'}, map[string][]string{
+			"k": {
+				"cannot mix characters from different scripts (‘а’ (U+0430))",
+				"looks too similar to ‘paypal’",
+			},
+		}},
+		{"teamw0rk.com", protected, []rune{'0'}, map[string][]string{
+			"k": {"looks too similar to ‘teamwork.com’"},
+		}},
+		{"TEAMW0RK.COM", protected, []rune{'0'}, map[string][]string{
+			"k": {"looks too similar to ‘teamwork.com’"},
+		}},
+		{"MICROS0FT", ConfusableOptions{Protected: []string{"microsoft"}}, []rune{'0'}, map[string][]string{
+			"k": {"looks too similar to ‘microsoft’"},
+		}},
+		{"paypaI", protected, []rune{'I'}, map[string][]string{
+			"k": {"looks too similar to ‘paypal’"},
+		}},
+		{"MICROSOFT", ConfusableOptions{Protected: []string{"microsoft"}}, nil, map[string][]string{}},
+		{"tеаmwоrk.com", ConfusableOptions{Protected: []string{"teamwork.com"}, AllowMixedScript: true},
+			[]rune{'е', 'а', 'о'}, map[string][]string{
+				"k": {"looks too similar to ‘teamwork.com’"},
+			}},
+		{"pаypal", ConfusableOptions{}, []rune{'а'}, map[string][]string{"k": {"foo"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			var out []rune
+			if len(tt.wantErrors["k"]) > 0 && tt.wantErrors["k"][0] == "foo" {
+				out = v.Confusable("k", tt.in, tt.opts, "foo")
+			} else {
+				out = v.Confusable("k", tt.in, tt.opts)
+			}
+
+			if !reflect.DeepEqual(v.Errors, tt.wantErrors) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErrors)
+			}
+			if !reflect.DeepEqual(out, tt.want) {
+				t.Errorf("\nout:  %q\nwant: %q\n", out, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron is a parsed cron expression; see ParseCron().
+type Cron struct {
+	// Bitsets of the allowed values for each field.
+	second, minute, hour, dom, month, dow uint64
+
+	// Day of month or day of week starts with "*"; if both are restricted a
+	// day matches if either field matches.
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSecond = cronField{name: "second", min: 0, max: 59}
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// 7 is also Sunday.
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// CronFieldError is the error ParseCron() returns for an invalid field.
+type CronFieldError struct {
+	Field string // e.g. "day of week"
+	Value string
+}
+
+func (e *CronFieldError) Error() string {
+	return fmt.Sprintf("validate: invalid %s field %q in cron expression", e.Field, e.Value)
+}
+
+// ParseCron parses a cron expression with 5 fields ("minute hour day-of-month
+// month day-of-week"), or 6 fields with seconds as the first field.
+//
+// Fields can be "*", values, ranges ("1-5"), steps ("*/15" or "0-30/10"), and
+// lists of those ("1,15,30"). Months and days of the week can be names, such
+// as "JAN" or "MON-FRI". "?" is the same as "*".
+//
+// The macros @yearly, @annually, @monthly, @weekly, @daily, @midnight, and
+// @hourly are supported.
+//
+// If both the day of month and day of week are restricted a day matches if
+// either field matches, like Vixie cron: "0 0 1 * MON" is the 1st of the month
+// and every Monday. A field starting with "*" (or "?") doesn't count as
+// restricted, so "0 0 */2 * MON" is every Monday on an odd day of the month.
+//
+// A *CronFieldError is returned if a field is invalid.
+func ParseCron(expr string) (*Cron, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		m, ok := cronMacros[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("validate: unknown cron macro %q", expr)
+		}
+		expr = m
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("validate: cron expression %q must have 5 or 6 fields", expr)
+	}
+
+	var (
+		c   = &Cron{}
+		err error
+	)
+	for i, f := range []struct {
+		field cronField
+		bits  *uint64
+	}{
+		{cronSecond, &c.second}, {cronMinute, &c.minute}, {cronHour, &c.hour},
+		{cronDom, &c.dom}, {cronMonth, &c.month}, {cronDow, &c.dow},
+	} {
+		*f.bits, err = parseCronField(fields[i], f.field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.domStar = strings.HasPrefix(fields[3], "*") || strings.HasPrefix(fields[3], "?")
+	c.dowStar = strings.HasPrefix(fields[5], "*") || strings.HasPrefix(fields[5], "?")
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	return c, nil
+}
+
+func parseCronField(value string, f cronField) (uint64, error) {
+	fieldErr := &CronFieldError{Field: f.name, Value: value}
+
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step < 1 || step > f.max {
+				return 0, fieldErr
+			}
+		}
+
+		var lo, hi int
+		switch {
+		case rng == "*" || rng == "?":
+			lo, hi = f.min, f.max
+		case strings.Contains(rng, "-"):
+			l, h, _ := strings.Cut(rng, "-")
+			var ok1, ok2 bool
+			lo, ok1 = f.value(l)
+			hi, ok2 = f.value(h)
+			if !ok1 || !ok2 || lo > hi {
+				return 0, fieldErr
+			}
+		default:
+			var ok bool
+			lo, ok = f.value(rng)
+			if !ok {
+				return 0, fieldErr
+			}
+			hi = lo
+			if hasStep {
+				hi = f.max
+			}
+		}
+
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, bool) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max || strings.HasPrefix(s, "+") {
+		return 0, false
+	}
+	return n, true
+}
+
+func (c *Cron) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next gets the next n times the expression fires after the given time, in the
+// time zone of after.
+//
+// Fewer times are returned if the expression doesn't fire within the next five
+// years, e.g. for "0 0 30 2 *".
+//
+// When the clocks change for daylight saving time, times that don't exist are
+// skipped and times that occur twice fire twice.
+func (c *Cron) Next(after time.Time, n int) []time.Time {
+	var times []time.Time
+	t := after
+	for len(times) < n {
+		var ok bool
+		t, ok = c.next(t)
+		if !ok {
+			break
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+func (c *Cron) next(after time.Time) (time.Time, bool) {
+	loc := after.Location()
+	t := after.Truncate(time.Second).Add(time.Second)
+	limit := after.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		// Step in absolute time within a day, as the local time may not exist
+		// or occur twice when the clocks change.
+		nextHour := t.Add(time.Duration(60-t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+
+		var n time.Time
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			n = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !c.matchDay(t):
+			n = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			n = nextHour
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			n = t.Add(time.Duration(60-t.Second()) * time.Second)
+		case c.second&(1<<uint(t.Second())) == 0:
+			n = t.Add(time.Second)
+		default:
+			return t, true
+		}
+
+		// Midnight may not exist either, in which case time.Date() can go back.
+		if !n.After(t) {
+			n = nextHour
+		}
+		t = n
+	}
+	return time.Time{}, false
+}
+
+// CronOptions are the options for the Cron() validator.
+type CronOptions struct {
+	// Allow expressions with 6 fields, where the first field is the seconds.
+	AllowSeconds bool
+
+	// Minimum time between two runs; the default of 0 means there is no
+	// limit. This is checked over the first 1,000 runs after 1 January 2001.
+	MinInterval time.Duration
+}
+
+// Cron validates that the string is a valid cron expression; see ParseCron().
+//
+// The error mentions the field that's invalid, e.g. "has an invalid hour field
+// ‘25’". Expressions that never fire, such as "0 0 30 2 *", are rejected.
+//
+// The parsed expression is returned, which can be used to get the next times
+// it fires with Next().
+func (v *Validator) Cron(key, value string, opts CronOptions, message ...string) *Cron {
+	if value == "" {
+		return nil
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) *Cron {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+		return nil
+	}
+
+	if !opts.AllowSeconds && len(strings.Fields(value)) == 6 {
+		return appendMsg(MessageCron)
+	}
+	c, err := ParseCron(value)
+	if err != nil {
+		if fe, ok := err.(*CronFieldError); ok {
+			return appendMsg(fmt.Sprintf(MessageCronField, fe.Field, fe.Value))
+		}
+		return appendMsg(MessageCron)
+	}
+
+	// Start on a Monday in a non-leap year, so that the first runs are
+	// representative.
+	times := c.Next(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second), 1000)
+	if len(times) == 0 {
+		return appendMsg(MessageCronNever)
+	}
+	if opts.MinInterval > 0 {
+		for i := 1; i < len(times); i++ {
+			if times[i].Sub(times[i-1]) < opts.MinInterval {
+				return appendMsg(fmt.Sprintf(MessageCronInterval, formatDuration(opts.MinInterval)))
+			}
+		}
+	}
+	return c
+}
@@ -0,0 +1,194 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr string
+	}{
+		{"* * * * *", ""},
+		{"0 9 * * MON-FRI", ""},
+		{"*/15 9-17 * * 1-5", ""},
+		{"0 0 1,15 * *", ""},
+		{"0 0 * jan,Jul sun", ""},
+		{"0-30/10 * * * *", ""},
+		{"5/20 * * * *", ""},
+		{"0 0 ? * 7", ""},
+		{"30 0 9 * * *", ""},
+		{"@daily", ""},
+		{"@Weekly", ""},
+
+		{"", "must have 5 or 6 fields"},
+		{"* * * *", "must have 5 or 6 fields"},
+		{"* * * * * * *", "must have 5 or 6 fields"},
+		{"@reboot", "unknown cron macro"},
+		{"60 * * * *", `invalid minute field "60"`},
+		{"* 24 * * *", `invalid hour field "24"`},
+		{"* * 0 * *", `invalid day of month field "0"`},
+		{"* * * 13 *", `invalid month field "13"`},
+		{"* * * * 8", `invalid day of week field "8"`},
+		{"* * * * MON-XYZ", `invalid day of week field "MON-XYZ"`},
+		{"*/0 * * * *", `invalid minute field "*/0"`},
+		{"5-1 * * * *", `invalid minute field "5-1"`},
+		{"1,,2 * * * *", `invalid minute field "1,,2"`},
+		{"-1 * * * *", `invalid minute field "-1"`},
+		{"61 * * * * *", `invalid second field "61"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := ParseCron(tt.in)
+			if !errorContains(err, tt.wantErr) {
+				t.Errorf("wrong error\nout:  %v\nwant: %v\n", err, tt.wantErr)
+			}
+		})
+	}
+
+	var fe *CronFieldError
+	if _, err := ParseCron("* 24 * * *"); !errors.As(err, &fe) || fe.Field != "hour" || fe.Value != "24" {
+		t.Errorf("wrong error: %#v", err)
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	after := time.Date(2020, 6, 1, 10, 7, 0, 0, time.UTC) // Monday
+
+	tests := []struct {
+		in   string
+		n    int
+		want []string
+	}{
+		{"*/15 * * * *", 3, []string{"2020-06-01 10:15:00", "2020-06-01 10:30:00", "2020-06-01 10:45:00"}},
+		{"0 9 * * MON-FRI", 3, []string{"2020-06-02 09:00:00", "2020-06-03 09:00:00", "2020-06-04 09:00:00"}},
+		{"0 9 * * 7", 2, []string{"2020-06-07 09:00:00", "2020-06-14 09:00:00"}},
+		{"0 0 31 * *", 2, []string{"2020-07-31 00:00:00", "2020-08-31 00:00:00"}},
+		{"0 0 29 2 *", 2, []string{"2024-02-29 00:00:00", "2028-02-29 00:00:00"}},
+		{"0 0 13 * FRI", 3, []string{"2020-06-05 00:00:00", "2020-06-12 00:00:00", "2020-06-13 00:00:00"}},
+		{"0 0 */2 * MON", 3, []string{"2020-06-15 00:00:00", "2020-06-29 00:00:00", "2020-07-13 00:00:00"}},
+		{"0 0 1-31/2 * MON", 4, []string{"2020-06-03 00:00:00", "2020-06-05 00:00:00", "2020-06-07 00:00:00", "2020-06-08 00:00:00"}},
+		{"0 0 13 * */2", 3, []string{"2020-06-13 00:00:00", "2020-08-13 00:00:00", "2020-09-13 00:00:00"}},
+		{"0 0 ? * MON", 2, []string{"2020-06-08 00:00:00", "2020-06-15 00:00:00"}},
+		{"30 */20 * * * *", 2, []string{"2020-06-01 10:20:30", "2020-06-01 10:40:30"}},
+		{"@monthly", 1, []string{"2020-07-01 00:00:00"}},
+		{"0 0 30 2 *", 3, nil},
+		{"* * * * *", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			c, err := ParseCron(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, n := range c.Next(after, tt.n) {
+				got = append(got, n.Format("2006-01-02 15:04:05"))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronNextDST(t *testing.T) {
+	load := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return loc
+	}
+	var (
+		ny      = load("America/New_York")
+		havana  = load("America/Havana") // Clocks change at midnight.
+		kolkata = load("Asia/Kolkata")   // UTC+05:30.
+	)
+
+	tests := []struct {
+		in    string
+		after time.Time
+		n     int
+		want  []string
+	}{
+		// Spring forward on 10 March 2024; 02:00 to 02:59 don't exist.
+		{"0 3 * * *", time.Date(2024, 3, 9, 0, 0, 0, 0, ny), 3, []string{
+			"2024-03-09 03:00:00 EST", "2024-03-10 03:00:00 EDT", "2024-03-11 03:00:00 EDT"}},
+		{"30 2 * * *", time.Date(2024, 3, 9, 0, 0, 0, 0, ny), 2, []string{
+			"2024-03-09 02:30:00 EST", "2024-03-11 02:30:00 EDT"}},
+		{"0 * * * *", time.Date(2024, 3, 10, 0, 30, 0, 0, ny), 3, []string{
+			"2024-03-10 01:00:00 EST", "2024-03-10 03:00:00 EDT", "2024-03-10 04:00:00 EDT"}},
+
+		// Fall back on 3 November 2024; 01:00 to 01:59 occur twice.
+		{"30 1 * * *", time.Date(2024, 11, 2, 12, 0, 0, 0, ny), 3, []string{
+			"2024-11-03 01:30:00 EDT", "2024-11-03 01:30:00 EST", "2024-11-04 01:30:00 EST"}},
+		{"0 3 * * *", time.Date(2024, 11, 2, 12, 0, 0, 0, ny), 2, []string{
+			"2024-11-03 03:00:00 EST", "2024-11-04 03:00:00 EST"}},
+
+		{"0 0 * * *", time.Date(2024, 3, 9, 12, 0, 0, 0, havana), 2, []string{
+			"2024-03-11 00:00:00 CDT", "2024-03-12 00:00:00 CDT"}},
+		{"15 10 * * *", time.Date(2024, 3, 9, 12, 0, 0, 0, kolkata), 1, []string{
+			"2024-03-10 10:15:00 IST"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			c, err := ParseCron(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, n := range c.Next(tt.after, tt.n) {
+				got = append(got, n.Format("2006-01-02 15:04:05 MST"))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCron(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    CronOptions
+		wantErr []string
+	}{
+		{"", CronOptions{}, nil},
+		{"0 9 * * MON", CronOptions{}, nil},
+		{"@hourly", CronOptions{}, nil},
+		{"0 9 * *", CronOptions{}, []string{MessageCron}},
+		{"@reboot", CronOptions{}, []string{MessageCron}},
+		{"0 0 9 * * MON", CronOptions{}, []string{MessageCron}},
+		{"0 0 9 * * MON", CronOptions{AllowSeconds: true}, nil},
+		{"0 25 * * *", CronOptions{}, []string{fmt.Sprintf(MessageCronField, "hour", "25")}},
+		{"0 9 * * FUN", CronOptions{}, []string{fmt.Sprintf(MessageCronField, "day of week", "FUN")}},
+		{"0 0 30 2 *", CronOptions{}, []string{MessageCronNever}},
+		{"*/5 * * * *", CronOptions{MinInterval: 5 * time.Minute}, nil},
+		{"*/5 * * * *", CronOptions{MinInterval: time.Hour},
+			[]string{fmt.Sprintf(MessageCronInterval, "1 hour")}},
+		{"0 9,10 * * MON", CronOptions{MinInterval: 2 * time.Hour},
+			[]string{fmt.Sprintf(MessageCronInterval, "2 hours")}},
+		{"0 9 * * *", CronOptions{MinInterval: 24 * time.Hour}, nil},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			c := v.Cron("schedule", tt.in, tt.opts)
+			if !reflect.DeepEqual(v.Errors["schedule"], tt.wantErr) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors["schedule"], tt.wantErr)
+			}
+			if (c == nil) != (tt.in == "" || tt.wantErr != nil) {
+				t.Errorf("wrong return: %#v", c)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultDateLayouts are the layouts DateTime() accepts if no layouts are given:
+// RFC 3339 and the most common ISO 8601 forms.
+//
+// Fractional seconds are accepted with all layouts that include seconds.
+var DefaultDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// DateTimeOptions are the options for the DateTime() validator.
+type DateTimeOptions struct {
+	// Layouts to try, in order; the default is DefaultDateLayouts.
+	Layouts []string
+
+	// Location for values without a time zone; the default is UTC.
+	Location *time.Location
+
+	// Minimum and maximum value; the zero value means there is no limit.
+	Min, Max time.Time
+
+	// Reject values before or after the current time, as returned by Now. The
+	// comparison uses the precision of the layout, so "today" is not in the
+	// past for a layout without a time.
+	NotPast, NotFuture bool
+
+	// Get the current time; the default is time.Now.
+	Now func() time.Time
+}
+
+// DateTime validates that the string is a date in one of the layouts, and that
+// it's within the bounds of the options.
+//
+// The parsed time is returned, or the zero time if the value is empty or if it
+// can't be parsed.
+func (v *Validator) DateTime(key, value string, opts DateTimeOptions, message ...string) time.Time {
+	if value == "" {
+		return time.Time{}
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+	}
+
+	layouts := opts.Layouts
+	if len(layouts) == 0 {
+		layouts = DefaultDateLayouts
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	t, layout, ok := parseTime(strings.TrimSpace(value), layouts, loc)
+	if !ok {
+		if len(opts.Layouts) == 0 {
+			appendMsg(MessageDateTime)
+		} else {
+			appendMsg(fmt.Sprintf(MessageDate, strings.Join(opts.Layouts, ", ")))
+		}
+		return time.Time{}
+	}
+
+	if !opts.Min.IsZero() && t.Before(opts.Min) {
+		appendMsg(fmt.Sprintf(MessageDateMin, opts.Min.In(loc).Format(layout)))
+	}
+	if !opts.Max.IsZero() && t.After(opts.Max) {
+		appendMsg(fmt.Sprintf(MessageDateMax, opts.Max.In(loc).Format(layout)))
+	}
+
+	if opts.NotPast || opts.NotFuture {
+		now := time.Now
+		if opts.Now != nil {
+			now = opts.Now
+		}
+		// Round the current time to the precision of the layout.
+		n := now().In(loc)
+		if p, err := time.ParseInLocation(layout, n.Format(layout), loc); err == nil {
+			n = p
+		}
+
+		if opts.NotPast && t.Before(n) {
+			appendMsg(MessageDatePast)
+		}
+		if opts.NotFuture && t.After(n) {
+			appendMsg(MessageDateFuture)
+		}
+	}
+
+	return t
+}
+
+// parseTime parses the value with the first layout that matches.
+func parseTime(value string, layouts []string, loc *time.Location) (time.Time, string, bool) {
+	for _, l := range layouts {
+		t, err := time.ParseInLocation(l, value, loc)
+		if err == nil {
+			return t, l, true
+		}
+	}
+	return time.Time{}, "", false
+}
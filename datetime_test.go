@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDateTime(t *testing.T) {
+	ams, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := func() time.Time { return time.Date(2020, 6, 15, 12, 30, 0, 0, time.UTC) }
+	date := func(y int, m time.Month, d, h, min, s int, loc *time.Location) time.Time {
+		return time.Date(y, m, d, h, min, s, 0, loc)
+	}
+
+	tests := []struct {
+		in      string
+		opts    DateTimeOptions
+		want    time.Time
+		wantErr []string
+	}{
+		{"", DateTimeOptions{}, time.Time{}, nil},
+		{"not a date", DateTimeOptions{}, time.Time{}, []string{MessageDateTime}},
+		{"2020-02-30", DateTimeOptions{}, time.Time{}, []string{MessageDateTime}},
+
+		// Default layouts.
+		{"2020-06-15", DateTimeOptions{}, date(2020, 6, 15, 0, 0, 0, time.UTC), nil},
+		{" 2020-06-15 ", DateTimeOptions{}, date(2020, 6, 15, 0, 0, 0, time.UTC), nil},
+		{"2020-06-15T10:11:12Z", DateTimeOptions{}, date(2020, 6, 15, 10, 11, 12, time.UTC), nil},
+		{"2020-06-15T10:11:12+02:00", DateTimeOptions{},
+			date(2020, 6, 15, 10, 11, 12, time.FixedZone("", 2*3600)), nil},
+		{"2020-06-15T10:11:12.5Z", DateTimeOptions{},
+			time.Date(2020, 6, 15, 10, 11, 12, 5e8, time.UTC), nil},
+		{"2020-06-15T10:11", DateTimeOptions{}, date(2020, 6, 15, 10, 11, 0, time.UTC), nil},
+		{"2020-06-15 10:11:12", DateTimeOptions{}, date(2020, 6, 15, 10, 11, 12, time.UTC), nil},
+
+		// Location.
+		{"2020-06-15 10:11:12", DateTimeOptions{Location: ams}, date(2020, 6, 15, 10, 11, 12, ams), nil},
+		{"2020-06-15T10:11:12Z", DateTimeOptions{Location: ams}, date(2020, 6, 15, 10, 11, 12, time.UTC), nil},
+
+		// Custom layouts.
+		{"15/06/2020", DateTimeOptions{Layouts: []string{"02/01/2006", "2006-01-02"}},
+			date(2020, 6, 15, 0, 0, 0, time.UTC), nil},
+		{"2020-06-15T10:11:12Z", DateTimeOptions{Layouts: []string{"02/01/2006", "2006-01-02"}},
+			time.Time{}, []string{fmt.Sprintf(MessageDate, "02/01/2006, 2006-01-02")}},
+
+		// Bounds.
+		{"2020-06-15", DateTimeOptions{Min: date(2020, 6, 15, 0, 0, 0, time.UTC)},
+			date(2020, 6, 15, 0, 0, 0, time.UTC), nil},
+		{"2020-06-14", DateTimeOptions{Min: date(2020, 6, 15, 0, 0, 0, time.UTC)},
+			date(2020, 6, 14, 0, 0, 0, time.UTC), []string{fmt.Sprintf(MessageDateMin, "2020-06-15")}},
+		{"2020-06-16", DateTimeOptions{Max: date(2020, 6, 15, 0, 0, 0, time.UTC)},
+			date(2020, 6, 16, 0, 0, 0, time.UTC), []string{fmt.Sprintf(MessageDateMax, "2020-06-15")}},
+		{"2020-06-15 23:00:00", DateTimeOptions{Location: ams, Max: date(2020, 6, 15, 20, 0, 0, time.UTC)},
+			date(2020, 6, 15, 23, 0, 0, ams), []string{fmt.Sprintf(MessageDateMax, "2020-06-15 22:00:00")}},
+
+		// Past and future.
+		{"2020-06-15", DateTimeOptions{NotPast: true, Now: now}, date(2020, 6, 15, 0, 0, 0, time.UTC), nil},
+		{"2020-06-14", DateTimeOptions{NotPast: true, Now: now},
+			date(2020, 6, 14, 0, 0, 0, time.UTC), []string{MessageDatePast}},
+		{"2020-06-15T12:00:00Z", DateTimeOptions{NotPast: true, Now: now},
+			date(2020, 6, 15, 12, 0, 0, time.UTC), []string{MessageDatePast}},
+		{"2020-06-15T13:00:00+02:00", DateTimeOptions{NotPast: true, Now: now},
+			date(2020, 6, 15, 13, 0, 0, time.FixedZone("", 2*3600)), []string{MessageDatePast}},
+		{"2020-06-15", DateTimeOptions{NotFuture: true, Now: now}, date(2020, 6, 15, 0, 0, 0, time.UTC), nil},
+		{"2020-06-16", DateTimeOptions{NotFuture: true, Now: now},
+			date(2020, 6, 16, 0, 0, 0, time.UTC), []string{MessageDateFuture}},
+		{"2020-06-16", DateTimeOptions{NotFuture: true, Now: now, Location: time.FixedZone("", 14*3600)},
+			date(2020, 6, 16, 0, 0, 0, time.FixedZone("", 14*3600)), nil},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			got := v.DateTime("date", tt.in, tt.opts)
+			if !got.Equal(tt.want) || got.Location().String() != tt.want.Location().String() {
+				t.Errorf("\nout:  %s\nwant: %s\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["date"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["date"], tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("message", func(t *testing.T) {
+		v := New()
+		v.DateTime("date", "2020-06-14", DateTimeOptions{NotPast: true, Now: now}, "oops")
+		if want := []string{"oops"}; !reflect.DeepEqual(v.Errors["date"], want) {
+			t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors["date"], want)
+		}
+	})
+}
@@ -0,0 +1,292 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/teamwork/mailaddress"
+)
+
+// MXResolver looks up the mail exchangers and IP addresses for a domain;
+// *net.Resolver satisfies this interface.
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// DefaultEmailProviders is a list of common email providers, used to suggest
+// corrections for misspelled domains.
+var DefaultEmailProviders = []string{
+	"aol.com", "email.com", "gmail.com", "gmx.com", "googlemail.com",
+	"hotmail.com", "icloud.com", "live.com", "mail.com", "me.com", "msn.com",
+	"outlook.com", "protonmail.com", "yahoo.com", "yandex.com", "ymail.com",
+	"zoho.com",
+}
+
+// DeliverabilityChecker checks if email domains can receive email, by looking
+// up the MX records or (as a fallback) the A and AAAA records of the domain.
+//
+// Results are cached, so a single checker should be shared. It's safe for
+// concurrent use.
+//
+// Use NewDeliverabilityChecker() to create a checker with useful defaults. The
+// zero value uses net.DefaultResolver, and has no cache, timeout, or
+// providers.
+type DeliverabilityChecker struct {
+	// Resolver for DNS lookups; net.DefaultResolver is used if this is nil.
+	Resolver MXResolver
+
+	// How long to cache results; the default of 0 disables the cache.
+	TTL time.Duration
+
+	// Timeout for the DNS lookups of a single check; the default of 0 means
+	// there is no timeout other than that of the context.
+	Timeout time.Duration
+
+	// Providers to suggest corrections for.
+	Providers []string
+
+	now     func() time.Time // Only set in tests.
+	mu      sync.Mutex
+	results map[string]deliverabilityResult
+}
+
+type deliverabilityResult struct {
+	ok      bool
+	expires time.Time
+}
+
+// Maximum number of cached results; expired results are removed when this is
+// reached.
+const maxDeliverabilityCache = 10000
+
+// NewDeliverabilityChecker creates a new checker with the resolver, a TTL of
+// one hour, a timeout of 5 seconds, and DefaultEmailProviders.
+//
+// net.DefaultResolver is used if the resolver is nil.
+func NewDeliverabilityChecker(r MXResolver) *DeliverabilityChecker {
+	if r == nil {
+		r = net.DefaultResolver
+	}
+	return &DeliverabilityChecker{
+		Resolver:  r,
+		TTL:       time.Hour,
+		Timeout:   5 * time.Second,
+		Providers: DefaultEmailProviders,
+	}
+}
+
+func (c *DeliverabilityChecker) timeNow() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
+
+// Check reports if the domain can receive email.
+//
+// The domain can receive email if it has MX records, or if it has no MX
+// records but has an A or AAAA record (RFC 5321, section 5.1). A "null MX"
+// record (RFC 7505) indicates the domain doesn't accept email.
+//
+// An error is returned if the lookup failed for reasons other than the domain
+// not existing, such as a timeout. These results are not cached.
+func (c *DeliverabilityChecker) Check(ctx context.Context, domain string) (bool, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+
+	c.mu.Lock()
+	r, ok := c.results[domain]
+	c.mu.Unlock()
+	if ok && c.timeNow().Before(r.expires) {
+		return r.ok, nil
+	}
+
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+
+	deliverable, err := c.lookup(ctx, domain)
+	if err != nil || c.TTL <= 0 {
+		return deliverable, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.results == nil {
+		c.results = make(map[string]deliverabilityResult)
+	}
+	if len(c.results) >= maxDeliverabilityCache {
+		now := c.timeNow()
+		for k, r := range c.results {
+			if !now.Before(r.expires) {
+				delete(c.results, k)
+			}
+		}
+		if len(c.results) >= maxDeliverabilityCache {
+			c.results = make(map[string]deliverabilityResult)
+		}
+	}
+	c.results[domain] = deliverabilityResult{ok: deliverable, expires: c.timeNow().Add(c.TTL)}
+	return deliverable, nil
+}
+
+func (c *DeliverabilityChecker) lookup(ctx context.Context, domain string) (bool, error) {
+	resolver := c.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	mx, err := resolver.LookupMX(ctx, domain)
+	switch {
+	case err == nil && len(mx) == 1 && (mx[0].Host == "." || mx[0].Host == ""):
+		return false, nil
+	case err == nil && len(mx) > 0:
+		return true, nil
+	case err != nil && !isNotFound(err):
+		return false, err
+	}
+
+	addrs, err := resolver.LookupIPAddr(ctx, domain)
+	switch {
+	case err == nil:
+		return len(addrs) > 0, nil
+	case isNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// Suggest gets a correction for a misspelled domain of a common email
+// provider, such as "gmail.com" for "gmial.com", or "" if there is none.
+//
+// The name before the top-level domain may have 1 edit if it's shorter than 6
+// characters and 2 edits otherwise, and the top-level domain may have 1 edit.
+// Names shorter than 4 characters are never corrected, as a single edit gives a
+// different but plausible domain (e.g. "aon.com" and "aol.com").
+func (c *DeliverabilityChecker) Suggest(domain string) string {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	name, tld := splitTLD(domain)
+
+	maxDist := 2
+	switch {
+	case len(name) < 4:
+		maxDist = 0
+	case len(name) < 6:
+		maxDist = 1
+	}
+
+	var (
+		best     string
+		bestDist = 4
+	)
+	for _, p := range c.Providers {
+		if p == domain {
+			return ""
+		}
+		pname, ptld := splitTLD(p)
+		nameDist, tldDist := editDistance(name, pname), editDistance(tld, ptld)
+		if nameDist <= maxDist && tldDist <= 1 && nameDist+tldDist < bestDist {
+			best, bestDist = p, nameDist+tldDist
+		}
+	}
+	return best
+}
+
+// splitTLD splits "mail.example.com" in "mail.example" and "com".
+func splitTLD(domain string) (name, tld string) {
+	i := strings.LastIndexByte(domain, '.')
+	if i == -1 {
+		return domain, ""
+	}
+	return domain[:i], domain[i+1:]
+}
+
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
+
+func minInt(n ...int) int {
+	m := n[0]
+	for _, i := range n[1:] {
+		if i < m {
+			m = i
+		}
+	}
+	return m
+}
+
+// EmailDeliverable validates that the domain of the email address can receive
+// email, using the checker. The value can be a bare address or include a name,
+// such as `"Bob" <bob@example.com>`; invalid addresses are ignored (use
+// Email() to validate them).
+//
+// If the domain can't receive email and looks like a misspelling of a common
+// provider the error includes a suggestion, e.g. "did you mean
+// ‘bob@gmail.com’", and the suggested address is returned. Domains that can
+// receive email never get a suggestion, as many legitimate domains are a few
+// edits away from a common provider.
+//
+// Lookup failures such as timeouts are not reported as an error, so that an
+// unreachable DNS server won't prevent people from signing up.
+func (v *Validator) EmailDeliverable(ctx context.Context, key, value string, c *DeliverabilityChecker, message ...string) string {
+	addr, err := mailaddress.Parse(value)
+	if err != nil || addr.Domain() == "" {
+		return ""
+	}
+
+	ok, err := c.Check(ctx, addr.Domain())
+	if err != nil || ok {
+		return ""
+	}
+
+	suggest := ""
+	if s := c.Suggest(addr.Domain()); s != "" {
+		suggest = addr.Local() + "@" + s
+	}
+
+	msg := getMessage(message, "")
+	switch {
+	case msg != "":
+	case suggest != "":
+		msg = fmt.Sprintf(MessageEmailSuggest, suggest)
+	default:
+		msg = MessageEmailUndeliverable
+	}
+	v.Append(key, msg)
+	return suggest
+}
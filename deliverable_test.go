@@ -0,0 +1,222 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fakeMXResolver struct {
+	mx    map[string][]string
+	ips   fakeResolver
+	fail  map[string]bool
+	calls int
+}
+
+func (r *fakeMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.calls++
+	if r.fail[name] {
+		return nil, &net.DNSError{Err: "i/o timeout", Name: name, IsTimeout: true}
+	}
+	hosts, ok := r.mx[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	mx := make([]*net.MX, len(hosts))
+	for i, h := range hosts {
+		mx[i] = &net.MX{Host: h, Pref: uint16(i)}
+	}
+	return mx, nil
+}
+
+func (r *fakeMXResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	addrs, err := r.ips.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, &net.DNSError{Err: err.Error(), Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func newFakeMXResolver() *fakeMXResolver {
+	return &fakeMXResolver{
+		mx: map[string][]string{
+			"gmail.com":   {"gmail-smtp-in.l.google.com."},
+			"gmal.com":    {"mx.gmal.com."},
+			"example.com": {"."},
+		},
+		ips: fakeResolver{
+			"a-only.com": {"93.184.216.34"},
+		},
+		fail: map[string]bool{"timeout.com": true},
+	}
+}
+
+func TestDeliverabilityCheck(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    bool
+		wantErr bool
+	}{
+		{"gmail.com", true, false},
+		{"GMAIL.com.", true, false},
+		{"a-only.com", true, false},
+		{"example.com", false, false},
+		{"nonexistent.com", false, false},
+		{"timeout.com", false, true},
+	}
+
+	c := NewDeliverabilityChecker(newFakeMXResolver())
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := c.Check(context.Background(), tt.in)
+			if got != tt.want || (err != nil) != tt.wantErr {
+				t.Errorf("\nout:  %#v, %v\nwant: %#v, error: %v\n", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeliverabilityCache(t *testing.T) {
+	r := newFakeMXResolver()
+	c := NewDeliverabilityChecker(r)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+
+	check := func(domain string, wantCalls int) {
+		t.Helper()
+		if _, err := c.Check(context.Background(), domain); err != nil && !errors.As(err, new(*net.DNSError)) {
+			t.Fatal(err)
+		}
+		if r.calls != wantCalls {
+			t.Errorf("calls: %d; want %d", r.calls, wantCalls)
+		}
+	}
+
+	check("gmail.com", 1)
+	check("gmail.com", 1)
+	check("Gmail.com", 1)
+
+	// Errors are not cached.
+	check("timeout.com", 2)
+	check("timeout.com", 3)
+
+	now = now.Add(c.TTL)
+	check("gmail.com", 4)
+}
+
+func TestDeliverabilityZero(t *testing.T) {
+	r := newFakeMXResolver()
+	c := &DeliverabilityChecker{Resolver: r}
+
+	for i := 1; i <= 2; i++ {
+		got, err := c.Check(context.Background(), "gmail.com")
+		if !got || err != nil {
+			t.Fatalf("\nout:  %#v, %v\nwant: true, <nil>\n", got, err)
+		}
+		// No TTL, so nothing is cached.
+		if r.calls != i {
+			t.Errorf("calls: %d; want %d", r.calls, i)
+		}
+	}
+	if s := c.Suggest("gmial.com"); s != "" {
+		t.Errorf("suggest without providers: %q", s)
+	}
+}
+
+func TestDeliverabilityTimeout(t *testing.T) {
+	c := NewDeliverabilityChecker(slowResolver{})
+	c.Timeout = 10 * time.Millisecond
+
+	_, err := c.Check(context.Background(), "example.com")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wrong error: %v", err)
+	}
+}
+
+type slowResolver struct{}
+
+func (slowResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (slowResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"gmail.com", ""},
+		{"gmial.com", "gmail.com"},
+		{"gmail.con", "gmail.com"},
+		{"GMAL.COM", "gmail.com"},
+		{"hotmial.co", "hotmail.com"},
+		{"yahooo.com", "yahoo.com"},
+		{"example.com", ""},
+		{"teamwork.com", ""},
+		{"ymail.com", ""},
+		{"email.com", ""},
+		{"aon.com", ""},
+		{"hp.com", ""},
+		{"yahoo.de", ""},
+		{"yaho.co", "yahoo.com"},
+		{"outlok.com", "outlook.com"},
+		{"gmail.co.uk", ""},
+	}
+
+	c := NewDeliverabilityChecker(nil)
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := c.Suggest(tt.in)
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailDeliverable(t *testing.T) {
+	tests := []struct {
+		in          string
+		message     []string
+		wantSuggest string
+		wantErr     []string
+	}{
+		{"", nil, "", nil},
+		{"bob@", nil, "", nil},
+		{"bob@gmail.com", nil, "", nil},
+		{"bob@a-only.com", nil, "", nil},
+		{"bob@gmal.com", nil, "", nil}, // Deliverable, so no suggestion.
+		{"bob@timeout.com", nil, "", nil},
+		{"bob@nonexistent.com", nil, "", []string{MessageEmailUndeliverable}},
+		{"bob@example.com", nil, "", []string{MessageEmailUndeliverable}},
+		{"bob@gmial.com", nil, "bob@gmail.com", []string{fmt.Sprintf(MessageEmailSuggest, "bob@gmail.com")}},
+		{"bob@gmial.com", []string{"oops"}, "bob@gmail.com", []string{"oops"}},
+		{"bob@a-only.com", nil, "", nil},
+		{`"Bob" <bob@gmail.com>`, nil, "", nil},
+		{`"Bob" <bob@gmial.com>`, nil, "bob@gmail.com", []string{fmt.Sprintf(MessageEmailSuggest, "bob@gmail.com")}},
+		{"Bob <bob@example.com>", nil, "", []string{MessageEmailUndeliverable}},
+		{"not an address", nil, "", nil},
+	}
+
+	c := NewDeliverabilityChecker(newFakeMXResolver())
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			suggest := v.EmailDeliverable(context.Background(), "email", tt.in, c, tt.message...)
+			if suggest != tt.wantSuggest {
+				t.Errorf("suggest\nout:  %#v\nwant: %#v\n", suggest, tt.wantSuggest)
+			}
+			if !reflect.DeepEqual(v.Errors["email"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["email"], tt.wantErr)
+			}
+		})
+	}
+}
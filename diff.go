@@ -0,0 +1,143 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Difference between two validators, as returned by Diff().
+type Difference struct {
+	// Keys that are only in the other validator, with their messages.
+	Missing map[string][]string
+
+	// Keys that are only in this validator, with their messages.
+	Extra map[string][]string
+
+	// Keys in both validators with different messages.
+	Messages map[string]MessageDifference
+}
+
+// MessageDifference is the difference in messages for a single key.
+type MessageDifference struct {
+	// Messages that are only in the other validator.
+	Missing []string
+
+	// Messages that are only in this validator.
+	Extra []string
+}
+
+// Diff gets the differences between this validator and another one.
+//
+// The differences are empty if and only if Equal() is true: the order of the
+// messages doesn't matter, duplicate messages are counted, and a nil Validator
+// is the same as one without errors.
+func (v *Validator) Diff(other *Validator) Difference {
+	d := Difference{
+		Missing:  make(map[string][]string),
+		Extra:    make(map[string][]string),
+		Messages: make(map[string]MessageDifference),
+	}
+
+	var verrs, oerrs map[string][]string
+	if v != nil {
+		verrs = v.Errors
+	}
+	if other != nil {
+		oerrs = other.Errors
+	}
+
+	for k, vmsgs := range verrs {
+		omsgs, ok := oerrs[k]
+		if !ok {
+			d.Extra[k] = vmsgs
+			continue
+		}
+
+		md := MessageDifference{
+			Missing: subtractMessages(omsgs, vmsgs),
+			Extra:   subtractMessages(vmsgs, omsgs),
+		}
+		if len(md.Missing) > 0 || len(md.Extra) > 0 {
+			d.Messages[k] = md
+		}
+	}
+	for k, omsgs := range oerrs {
+		if _, ok := verrs[k]; !ok {
+			d.Missing[k] = omsgs
+		}
+	}
+
+	return d
+}
+
+// subtractMessages gets all messages in a that aren't in b.
+func subtractMessages(a, b []string) []string {
+	count := make(map[string]int, len(b))
+	for _, m := range b {
+		count[m]++
+	}
+
+	var out []string
+	for _, m := range a {
+		if count[m] > 0 {
+			count[m]--
+			continue
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// Empty reports if there are no differences.
+func (d Difference) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Messages) == 0
+}
+
+// String shows a readable report of the differences, or "<no differences>" if
+// there are none.
+func (d Difference) String() string {
+	if d.Empty() {
+		return "<no differences>"
+	}
+
+	keys := make([]string, 0, len(d.Missing)+len(d.Extra)+len(d.Messages))
+	for k := range d.Missing {
+		keys = append(keys, k)
+	}
+	for k := range d.Extra {
+		keys = append(keys, k)
+	}
+	for k := range d.Messages {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		if msgs, ok := d.Missing[k]; ok {
+			fmt.Fprintf(&b, "missing key %q: %s\n", k, quoteMessages(msgs))
+		}
+		if msgs, ok := d.Extra[k]; ok {
+			fmt.Fprintf(&b, "extra key %q: %s\n", k, quoteMessages(msgs))
+		}
+		if md, ok := d.Messages[k]; ok {
+			fmt.Fprintf(&b, "key %q:\n", k)
+			if len(md.Missing) > 0 {
+				fmt.Fprintf(&b, "    missing: %s\n", quoteMessages(md.Missing))
+			}
+			if len(md.Extra) > 0 {
+				fmt.Fprintf(&b, "    extra:   %s\n", quoteMessages(md.Extra))
+			}
+		}
+	}
+	return b.String()
+}
+
+func quoteMessages(msgs []string) string {
+	q := make([]string, len(msgs))
+	for i, m := range msgs {
+		q[i] = fmt.Sprintf("%q", m)
+	}
+	return strings.Join(q, ", ")
+}
@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		in   *Validator
+		vs   *Validator
+		want string
+	}{
+		{nil, nil, "<no differences>"},
+		{nil, &Validator{}, "<no differences>"},
+		{
+			&Validator{Errors: map[string][]string{"a": {"A", "AA"}, "b": {"B"}}},
+			&Validator{Errors: map[string][]string{"b": {"B"}, "a": {"AA", "A"}}},
+			"<no differences>",
+		},
+		{
+			nil,
+			&Validator{Errors: map[string][]string{"a": {"A"}}},
+			"missing key \"a\": \"A\"\n",
+		},
+		{
+			&Validator{Errors: map[string][]string{"a": {"A", "AA"}}},
+			nil,
+			"extra key \"a\": \"A\", \"AA\"\n",
+		},
+		{
+			&Validator{Errors: map[string][]string{"a": {"A"}, "c": {"C"}}},
+			&Validator{Errors: map[string][]string{"b": {"B"}, "a": {"A"}}},
+			"missing key \"b\": \"B\"\nextra key \"c\": \"C\"\n",
+		},
+		{
+			&Validator{Errors: map[string][]string{"a": {"A", "X"}, "b": {"B"}}},
+			&Validator{Errors: map[string][]string{"b": {"B", "BB"}, "a": {"A", "AA"}}},
+			"key \"a\":\n    missing: \"AA\"\n    extra:   \"X\"\n" +
+				"key \"b\":\n    missing: \"BB\"\n",
+		},
+		{
+			&Validator{Errors: map[string][]string{"a": {"A", "A"}}},
+			&Validator{Errors: map[string][]string{"a": {"A"}}},
+			"key \"a\":\n    extra:   \"A\"\n",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			d := tt.in.Diff(tt.vs)
+			if out := d.String(); out != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+			if d.Empty() != (tt.want == "<no differences>") {
+				t.Errorf("Empty() is %t", d.Empty())
+			}
+		})
+	}
+}
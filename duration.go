@@ -0,0 +1,142 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationOptions are the options for the Duration() and ISODuration()
+// validators.
+type DurationOptions struct {
+	// Minimum and maximum duration; the default of 0 means there is no limit.
+	Min, Max time.Duration
+
+	// Reject negative durations, such as "-5m" or "-PT5M".
+	RejectNegative bool
+}
+
+// Duration validates that the string is a duration as accepted by
+// time.ParseDuration(), such as "90m" or "1h30m", and that it's within the
+// bounds of the options.
+//
+// The parsed duration is returned.
+func (v *Validator) Duration(key, value string, opts DurationOptions, message ...string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		v.Append(key, getMessage(message, MessageDuration))
+		return 0
+	}
+	v.checkDuration(key, d, opts, message)
+	return d
+}
+
+// ISODuration validates that the string is an ISO 8601 duration, such as
+// "PT1H30M" or "P1DT12H", and that it's within the bounds of the options.
+//
+// Years and months are rejected, as they don't have a fixed duration. See
+// ParseISODuration() for details.
+//
+// The parsed duration is returned.
+func (v *Validator) ISODuration(key, value string, opts DurationOptions, message ...string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	d, err := ParseISODuration(strings.TrimSpace(value))
+	if err != nil {
+		m := MessageDurationISO
+		if errors.Is(err, ErrDurationCalendar) {
+			m = MessageDurationCalendar
+		}
+		v.Append(key, getMessage(message, m))
+		return 0
+	}
+	v.checkDuration(key, d, opts, message)
+	return d
+}
+
+func (v *Validator) checkDuration(key string, d time.Duration, opts DurationOptions, message []string) {
+	switch {
+	case opts.RejectNegative && d < 0:
+		v.Append(key, getMessage(message, MessageDurationNegative))
+	case opts.Min != 0 && d < opts.Min:
+		v.Append(key, getMessage(message, fmt.Sprintf(MessageDurationMin, formatDuration(opts.Min))))
+	case opts.Max != 0 && d > opts.Max:
+		v.Append(key, getMessage(message, fmt.Sprintf(MessageDurationMax, formatDuration(opts.Max))))
+	}
+}
+
+// ErrDurationCalendar is returned by ParseISODuration() for durations with years
+// or months.
+var ErrDurationCalendar = errors.New("validate: years and months don't have a fixed duration")
+
+var reISODuration = regexp.MustCompile(`^([-+])?P` +
+	`(?:(\d+(?:[.,]\d+)?)Y)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?` +
+	`(T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// Units for the submatches of reISODuration; years and months have no unit.
+var isoDurationUnits = []struct {
+	group int
+	unit  time.Duration
+}{
+	{2, 0}, {3, 0}, {4, 7 * 24 * time.Hour}, {5, 24 * time.Hour},
+	{7, time.Hour}, {8, time.Minute}, {9, time.Second},
+}
+
+// ParseISODuration parses an ISO 8601 duration, such as "PT1H30M", "P2W", or
+// "-P1DT0.5S".
+//
+// Days are always 24 hours. Durations with years or months return
+// ErrDurationCalendar, unless they're 0. Only the smallest unit may have a
+// fraction, which can use a comma or dot.
+func ParseISODuration(s string) (time.Duration, error) {
+	m := reISODuration.FindStringSubmatch(s)
+	if m == nil || strings.HasSuffix(s, "T") || strings.HasSuffix(s, "P") {
+		return 0, fmt.Errorf("validate: invalid ISO 8601 duration %q", s)
+	}
+
+	var (
+		total    float64
+		fraction bool
+	)
+	for _, u := range isoDurationUnits {
+		n := m[u.group]
+		if n == "" {
+			continue
+		}
+		if fraction {
+			return 0, fmt.Errorf("validate: invalid ISO 8601 duration %q: only the smallest unit can have a fraction", s)
+		}
+		fraction = strings.ContainsAny(n, ".,")
+
+		f, err := strconv.ParseFloat(strings.Replace(n, ",", ".", 1), 64)
+		if err != nil {
+			return 0, fmt.Errorf("validate: invalid ISO 8601 duration %q: %w", s, err)
+		}
+		if u.unit == 0 {
+			if f != 0 {
+				return 0, ErrDurationCalendar
+			}
+			continue
+		}
+		total += f * float64(u.unit)
+	}
+
+	if total >= math.MaxInt64 {
+		return 0, fmt.Errorf("validate: ISO 8601 duration %q is too large", s)
+	}
+	d := time.Duration(math.Round(total))
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
@@ -0,0 +1,123 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr string
+	}{
+		{"PT1H30M", 90 * time.Minute, ""},
+		{"PT90M", 90 * time.Minute, ""},
+		{"P1DT12H", 36 * time.Hour, ""},
+		{"P2W", 14 * 24 * time.Hour, ""},
+		{"PT0S", 0, ""},
+		{"P0Y0M1D", 24 * time.Hour, ""},
+		{"PT0.5S", 500 * time.Millisecond, ""},
+		{"PT1,5H", 90 * time.Minute, ""},
+		{"-PT5M", -5 * time.Minute, ""},
+		{"+PT5M", 5 * time.Minute, ""},
+
+		{"", 0, `invalid ISO 8601 duration ""`},
+		{"P", 0, `invalid ISO 8601 duration "P"`},
+		{"PT", 0, `invalid ISO 8601 duration "PT"`},
+		{"P1DT", 0, `invalid ISO 8601 duration "P1DT"`},
+		{"1H", 0, `invalid ISO 8601 duration "1H"`},
+		{"PT1M1H", 0, `invalid ISO 8601 duration "PT1M1H"`},
+		{"P1H", 0, `invalid ISO 8601 duration "P1H"`},
+		{"pt1h", 0, `invalid ISO 8601 duration "pt1h"`},
+		{"PT1.5H30M", 0, "only the smallest unit can have a fraction"},
+		{"P1Y", 0, "years and months"},
+		{"P1M", 0, "years and months"},
+		{"P99999999999999D", 0, "too large"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseISODuration(tt.in)
+			if !errorContains(err, tt.wantErr) {
+				t.Fatalf("wrong error\nout:  %v\nwant: %v\n", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("\nout:  %v\nwant: %v\n", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseISODuration("P1Y"); !errors.Is(err, ErrDurationCalendar) {
+		t.Errorf("wrong error: %v", err)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    DurationOptions
+		want    time.Duration
+		wantErr []string
+	}{
+		{"", DurationOptions{}, 0, nil},
+		{"90m", DurationOptions{}, 90 * time.Minute, nil},
+		{" 1h30m ", DurationOptions{}, 90 * time.Minute, nil},
+		{"1d", DurationOptions{}, 0, []string{MessageDuration}},
+		{"-5m", DurationOptions{}, -5 * time.Minute, nil},
+		{"-5m", DurationOptions{RejectNegative: true}, -5 * time.Minute, []string{MessageDurationNegative}},
+		{"5m", DurationOptions{Min: 15 * time.Minute}, 5 * time.Minute,
+			[]string{fmt.Sprintf(MessageDurationMin, "15 minutes")}},
+		{"5h", DurationOptions{Max: 4 * time.Hour}, 5 * time.Hour,
+			[]string{fmt.Sprintf(MessageDurationMax, "4 hours")}},
+		{"4h", DurationOptions{Min: time.Hour, Max: 4 * time.Hour}, 4 * time.Hour, nil},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			got := v.Duration("d", tt.in, tt.opts)
+			if got != tt.want {
+				t.Errorf("\nout:  %v\nwant: %v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["d"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["d"], tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestISODuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    DurationOptions
+		message []string
+		want    time.Duration
+		wantErr []string
+	}{
+		{"", DurationOptions{}, nil, 0, nil},
+		{"PT1H30M", DurationOptions{}, nil, 90 * time.Minute, nil},
+		{"90m", DurationOptions{}, nil, 0, []string{MessageDurationISO}},
+		{"P1M", DurationOptions{}, nil, 0, []string{MessageDurationCalendar}},
+		{"P1M", DurationOptions{}, []string{"oops"}, 0, []string{"oops"}},
+		{"-PT1M", DurationOptions{RejectNegative: true}, nil, -time.Minute, []string{MessageDurationNegative}},
+		{"P2D", DurationOptions{Max: 24 * time.Hour}, nil, 48 * time.Hour,
+			[]string{fmt.Sprintf(MessageDurationMax, "1 day")}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			got := v.ISODuration("d", tt.in, tt.opts, tt.message...)
+			if got != tt.want {
+				t.Errorf("\nout:  %v\nwant: %v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["d"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["d"], tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/teamwork/mailaddress"
+)
+
+// DefaultDisposableDomains is a list of common disposable email providers, for
+// use in EmailOptions.DisposableDomains.
+//
+// This list is far from complete; applications which need to block disposable
+// addresses should maintain their own list.
+var DefaultDisposableDomains = []string{
+	"10minutemail.com", "discard.email", "dispostable.com", "fakeinbox.com",
+	"getnada.com", "guerrillamail.com", "maildrop.cc", "mailinator.com",
+	"mailnesia.com", "mintemail.com", "mohmal.com", "sharklasers.com",
+	"spamgourmet.com", "temp-mail.org", "tempmail.com", "throwawaymail.com",
+	"trashmail.com", "yopmail.com",
+}
+
+// EmailOptions are the options for the EmailWithOptions() validator.
+type EmailOptions struct {
+	// Enforce the RFC 5321 length limits: 64 bytes for the local part, and 254
+	// bytes for the entire address.
+	LimitLength bool
+
+	// Reject internationalized addresses with non-ASCII characters in the
+	// local part or domain (RFC 6531).
+	ASCIIOnly bool
+
+	// Reject addresses at these domains, or any subdomain of them; e.g.
+	// DefaultDisposableDomains.
+	DisposableDomains []string
+
+	// Require that the domain is a registrable domain or a subdomain of one,
+	// according to the Public Suffix List. This rejects addresses such as
+	// "me@co.uk" or "me@example.notatld".
+	RequireRegistrable bool
+
+	// Only accept a bare address such as "bob@example.com", and not forms with
+	// a display name such as `"Bob" <bob@example.com>`.
+	BareAddress bool
+}
+
+// EmailWithOptions validates if this email looks like a valid email address,
+// with the additional checks from the options.
+func (v *Validator) EmailWithOptions(key, value string, opts EmailOptions, message ...string) mailaddress.Address {
+	if value == "" {
+		return mailaddress.Address{}
+	}
+
+	msg := getMessage(message, "")
+	addr, err := mailaddress.Parse(value)
+	if err != nil {
+		if msg == "" {
+			msg = MessageEmail
+		}
+		v.Append(key, msg)
+		return addr
+	}
+
+	if opts.BareAddress && (addr.Name != "" || strings.ContainsAny(value, "<>")) {
+		if msg == "" {
+			msg = MessageEmailBare
+		}
+		v.Append(key, msg)
+		return addr
+	}
+
+	if m := checkEmail(addr.Address, opts); m != "" {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+	}
+	return addr
+}
+
+// checkEmail checks the address with the options, returning the error message
+// if it fails.
+func checkEmail(address string, opts EmailOptions) string {
+	at := strings.LastIndexByte(address, '@')
+	if at == -1 {
+		return MessageEmail
+	}
+	local, domain := address[:at], strings.ToLower(strings.TrimSuffix(address[at+1:], "."))
+
+	switch {
+	case opts.LimitLength && (len(local) > 64 || len(address) > 254):
+		return MessageEmailLength
+	case opts.ASCIIOnly && !isASCII(address):
+		return MessageEmailASCII
+	case isDisposable(domain, opts.DisposableDomains):
+		return MessageEmailDisposable
+	case opts.RequireRegistrable && !isRegistrable(domain):
+		return MessageEmailDomain
+	}
+	return ""
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func isDisposable(domain string, disposable []string) bool {
+	for _, d := range disposable {
+		d = strings.ToLower(d)
+		if domain == d || strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRegistrable reports if the domain is below a listed public suffix.
+func isRegistrable(domain string) bool {
+	labels, ok := domainLabels(domain)
+	if !ok {
+		return false
+	}
+	n, _, listed := DefaultPublicSuffixList().publicSuffix(labels, false)
+	return listed && n < len(labels)
+}
+
+// EmailListOptions are the options for the EmailList() validator.
+type EmailListOptions struct {
+	// Options for every address in the list.
+	EmailOptions
+
+	// Maximum number of addresses; the default of 0 means there is no limit.
+	MaxCount int
+
+	// Only allow addresses at these domains (but not their subdomains).
+	AllowedDomains []string
+}
+
+// EmailList validates that the string is a comma-separated list of valid email
+// addresses, such as `bob@example.com, "Alice" <alice@example.com>`.
+//
+// Errors for an address are added as "key[n]", where n is the index of the
+// address in the list. Addresses that occur more than once (compared
+// case-insensitive) are reported as a duplicate.
+func (v *Validator) EmailList(key, value string, opts EmailListOptions, message ...string) mailaddress.List {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(p Path, m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.AppendPath(p, m)
+	}
+
+	list, _ := mailaddress.ParseList(value)
+	if opts.MaxCount > 0 && len(list) > opts.MaxCount {
+		appendMsg(Path{PathField(key)}, fmt.Sprintf(MessageEmailListMax, opts.MaxCount))
+	}
+
+	seen := make(map[string]bool, len(list))
+	for i, addr := range list {
+		p := Path{PathField(key), PathIndex(strconv.Itoa(i))}
+
+		if addr.Error != nil {
+			appendMsg(p, MessageEmail)
+			continue
+		}
+		if opts.BareAddress && (addr.Name != "" || strings.ContainsAny(addr.Raw, "<>")) {
+			appendMsg(p, MessageEmailBare)
+			continue
+		}
+		if m := checkEmail(addr.Address, opts.EmailOptions); m != "" {
+			appendMsg(p, m)
+			continue
+		}
+
+		if len(opts.AllowedDomains) > 0 && !includeString(opts.AllowedDomains, addr.Domain()) {
+			appendMsg(p, fmt.Sprintf(MessageEmailListDomain, strings.Join(opts.AllowedDomains, ", ")))
+			continue
+		}
+
+		lower := strings.ToLower(addr.Address)
+		if seen[lower] {
+			appendMsg(p, MessageEmailListDuplicate)
+			continue
+		}
+		seen[lower] = true
+	}
+
+	return list
+}
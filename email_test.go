@@ -0,0 +1,136 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEmailWithOptions(t *testing.T) {
+	long := strings.Repeat("a", 64)
+	longDomain := strings.Repeat(strings.Repeat("b", 50)+".", 5) + "com"
+
+	tests := []struct {
+		in      string
+		opts    EmailOptions
+		want    string
+		wantErr string
+	}{
+		{"", EmailOptions{}, "", ""},
+		{"bob@example.com", EmailOptions{}, "bob@example.com", ""},
+		{`"Bob" <bob@example.com>`, EmailOptions{}, "bob@example.com", ""},
+		{"bob@mailinator.com", EmailOptions{}, "bob@mailinator.com", ""},
+		{"bøb@exämple.com", EmailOptions{}, "bøb@exämple.com", ""},
+		{"asd", EmailOptions{}, "", "must be a valid email address"},
+
+		{long + "@example.com", EmailOptions{LimitLength: true}, long + "@example.com", ""},
+		{long + "a@example.com", EmailOptions{LimitLength: true}, long + "a@example.com",
+			"is too long for an email address"},
+		{"a@" + longDomain, EmailOptions{LimitLength: true}, "a@" + longDomain, "is too long for an email address"},
+
+		{"bøb@example.com", EmailOptions{ASCIIOnly: true}, "bøb@example.com",
+			"cannot contain non-ASCII characters"},
+		{"bob@exämple.com", EmailOptions{ASCIIOnly: true}, "bob@exämple.com",
+			"cannot contain non-ASCII characters"},
+
+		{"bob@Mailinator.COM", EmailOptions{DisposableDomains: DefaultDisposableDomains}, "bob@Mailinator.COM",
+			"cannot be a disposable email address"},
+		{"bob@eu.mailinator.com", EmailOptions{DisposableDomains: DefaultDisposableDomains}, "bob@eu.mailinator.com",
+			"cannot be a disposable email address"},
+		{"bob@notmailinator.com", EmailOptions{DisposableDomains: DefaultDisposableDomains}, "bob@notmailinator.com", ""},
+
+		{"bob@mail.example.co.uk", EmailOptions{RequireRegistrable: true}, "bob@mail.example.co.uk", ""},
+		{"bob@co.uk", EmailOptions{RequireRegistrable: true}, "bob@co.uk", "must have a valid email domain"},
+		{"bob@example.notatld", EmailOptions{RequireRegistrable: true}, "bob@example.notatld",
+			"must have a valid email domain"},
+
+		{"bob@example.com", EmailOptions{BareAddress: true}, "bob@example.com", ""},
+		{`"Bob" <bob@example.com>`, EmailOptions{BareAddress: true}, "bob@example.com",
+			"must be an email address without a name"},
+		{`<bob@example.com>`, EmailOptions{BareAddress: true}, "bob@example.com",
+			"must be an email address without a name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			out := v.EmailWithOptions("k", tt.in, tt.opts)
+
+			want := map[string][]string{}
+			if tt.wantErr != "" {
+				want["k"] = []string{tt.wantErr}
+			}
+			if !reflect.DeepEqual(v.Errors, want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, want)
+			}
+			if out.Address != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out.Address, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailList(t *testing.T) {
+	tests := []struct {
+		in         string
+		opts       EmailListOptions
+		want       []string
+		wantErrors map[string][]string
+	}{
+		{"", EmailListOptions{}, nil, map[string][]string{}},
+		{" ", EmailListOptions{}, nil, map[string][]string{}},
+		{"a@example.com", EmailListOptions{}, []string{"a@example.com"}, map[string][]string{}},
+		{`a@example.com, "Bob, Jr" <b@example.com>`, EmailListOptions{},
+			[]string{"a@example.com", "b@example.com"}, map[string][]string{}},
+		{"a@example.com, x, c@example.com", EmailListOptions{},
+			[]string{"a@example.com", "", "c@example.com"},
+			map[string][]string{"k[1]": {"must be a valid email address"}}},
+		{"a@example.com, b@example.com, A@Example.com", EmailListOptions{},
+			[]string{"a@example.com", "b@example.com", "A@Example.com"},
+			map[string][]string{"k[2]": {"is a duplicate address"}}},
+		{"a@example.com, b@example.com, c@example.com", EmailListOptions{MaxCount: 2},
+			[]string{"a@example.com", "b@example.com", "c@example.com"},
+			map[string][]string{"k": {"cannot have more than 2 addresses"}}},
+		{"a@example.com, b@other.com, c@sub.example.com", EmailListOptions{AllowedDomains: []string{"Example.com"}},
+			[]string{"a@example.com", "b@other.com", "c@sub.example.com"},
+			map[string][]string{
+				"k[1]": {"must be an address at ‘Example.com’"},
+				"k[2]": {"must be an address at ‘Example.com’"},
+			}},
+		{`a@example.com, "B" <b@example.com>, c@mailinator.com`, EmailListOptions{EmailOptions: EmailOptions{
+			BareAddress: true, DisposableDomains: DefaultDisposableDomains}},
+			[]string{"a@example.com", "b@example.com", "c@mailinator.com"},
+			map[string][]string{
+				"k[1]": {"must be an email address without a name"},
+				"k[2]": {"cannot be a disposable email address"},
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			out := v.EmailList("k", tt.in, tt.opts)
+
+			if !reflect.DeepEqual(v.Errors, tt.wantErrors) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErrors)
+			}
+
+			var addrs []string
+			for _, a := range out {
+				addrs = append(addrs, a.Address)
+			}
+			if !reflect.DeepEqual(addrs, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", addrs, tt.want)
+			}
+		})
+	}
+
+	t.Run("path", func(t *testing.T) {
+		v := New()
+		v.EmailList("to.list", "x", EmailListOptions{})
+		want := Path{PathField("to.list"), PathIndex("0")}
+		if out := v.Path("to.list[0]"); !reflect.DeepEqual(out, want) {
+			t.Errorf("\nout:  %#v\nwant: %#v\n", out, want)
+		}
+	})
+}
@@ -1,15 +1,20 @@
 module github.com/teamwork/validate
 
-go 1.15
+go 1.18
 
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/google/go-cmp v0.2.0
+	github.com/teamwork/mailaddress v0.0.0-20180417011037-e0bce973c1a8
+	golang.org/x/net v0.17.0
+	golang.org/x/text v0.13.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/hashicorp/errwrap v1.0.0 // indirect
 	github.com/hashicorp/go-multierror v1.0.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/teamwork/mailaddress v0.0.0-20180417011037-e0bce973c1a8
 	github.com/teamwork/test v0.0.0-20181126061546-2ff8918eb6a4 // indirect
 	github.com/teamwork/toutf8 v0.0.0-20180417010523-908c4b127591 // indirect
 	github.com/teamwork/utils v0.0.0-20190114034940-d6a1f27ce92c // indirect
-	golang.org/x/text v0.3.0 // indirect
 )
@@ -0,0 +1,94 @@
+package validate
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+)
+
+// IDN is a domain name in both its Unicode and ASCII (punycode) forms, e.g.
+// "bücher.example" and "xn--bcher-kva.example".
+type IDN struct {
+	Unicode string
+	ASCII   string
+}
+
+// The input is first mapped with the lookup profile (e.g. case folding), and
+// then validated with the stricter registration profile, which only allows
+// code points which are valid in IDNA 2008.
+var (
+	mapIDNA    = idna.New(idna.MapForLookup(), idna.Transitional(false))
+	strictIDNA = idna.New(
+		idna.ValidateForRegistration(),
+		idna.BidiRule(),
+		idna.ValidateLabels(true),
+		idna.StrictDomainName(true),
+		idna.VerifyDNSLength(true),
+		idna.Transitional(false),
+	)
+)
+
+// StrictDomain validates that the domain is valid according to the RFCs, and
+// returns it in both Unicode and ASCII form.
+//
+// Unlike Domain() this converts the domain with IDNA 2008 (RFC 5891), and:
+//
+//   - limits labels to 63 bytes and the domain to 253 bytes, in ASCII form;
+//   - only allows letters, digits, and hyphens in ASCII labels;
+//   - rejects labels starting or ending with a hyphen;
+//   - rejects code points which are disallowed by IDNA, such as symbols and
+//     punctuation, and labels which violate the bidi and joiner rules.
+//
+// The input is mapped before validating, so "Bücher.EXAMPLE" is accepted as
+// "bücher.example". A trailing dot is removed. Like Domain() the domain must
+// consist of at least two labels.
+func (v *Validator) StrictDomain(key, value string, message ...string) IDN {
+	if value == "" {
+		return IDN{}
+	}
+
+	msg := getMessage(message, MessageDomain)
+	mapped, err := mapIDNA.ToUnicode(strings.TrimSuffix(value, "."))
+	if err != nil {
+		v.Append(key, msg)
+		return IDN{}
+	}
+	ascii, err := strictIDNA.ToASCII(mapped)
+	if err != nil || !strings.Contains(ascii, ".") {
+		v.Append(key, msg)
+		return IDN{}
+	}
+
+	uni, err := strictIDNA.ToUnicode(ascii)
+	if err != nil || !idna2008Runes(uni) {
+		v.Append(key, msg)
+		return IDN{}
+	}
+	return IDN{Unicode: uni, ASCII: ascii}
+}
+
+// Contextual code points which are allowed by IDNA 2008 (RFC 5892, appendix
+// A). The joiners (U+200C, U+200D) are checked by the idna package.
+var idnaContextRunes = map[rune]bool{
+	'\u00b7': true, '\u0375': true, '\u05f3': true, '\u05f4': true,
+	'\u30fb': true, '\u200c': true, '\u200d': true,
+}
+
+// idna2008Runes reports if all runes are valid in IDNA 2008.
+//
+// The idna package validates against the UTS 46 tables, which also allow
+// symbols and punctuation that are disallowed in IDNA 2008 (marked as "NV8"),
+// such as "♥". This checks the general categories of the code points, which is
+// a close approximation of the IDNA 2008 rules.
+func idna2008Runes(s string) bool {
+	for _, r := range s {
+		switch {
+		case r == '.' || r == '-' || idnaContextRunes[r]:
+		case unicode.In(r, unicode.Ll, unicode.Lo, unicode.Lm, unicode.Mn, unicode.Mc, unicode.Nd):
+		default:
+			return false
+		}
+	}
+	return true
+}
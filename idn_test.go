@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStrictDomain(t *testing.T) {
+	tests := []struct {
+		in   string
+		want IDN
+	}{
+		{"", IDN{}},
+		{"example.com", IDN{"example.com", "example.com"}},
+		{"Example.COM.", IDN{"example.com", "example.com"}},
+		{"bücher.example", IDN{"bücher.example", "xn--bcher-kva.example"}},
+		{"BÜCHER.example", IDN{"bücher.example", "xn--bcher-kva.example"}},
+		{"xn--bcher-kva.example", IDN{"bücher.example", "xn--bcher-kva.example"}},
+		{"ราคา.example", IDN{"ราคา.example", "xn--42c5d0bb.example"}},
+		{"a-b.example", IDN{"a-b.example", "a-b.example"}},
+		{strings.Repeat("a", 63) + ".com", IDN{strings.Repeat("a", 63) + ".com", strings.Repeat("a", 63) + ".com"}},
+		{strings.Repeat("abcdefghi.", 25) + "com", IDN{
+			strings.Repeat("abcdefghi.", 25) + "com", strings.Repeat("abcdefghi.", 25) + "com"}},
+
+		// Label length is in bytes of the ASCII form.
+		{strings.Repeat("é", 32) + ".com", IDN{strings.Repeat("é", 32) + ".com",
+			"xn--9caaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.com"}},
+		{strings.Repeat("é", 60) + ".com", IDN{}},
+
+		// Invalid
+		{"com", IDN{}},
+		{"-example.com", IDN{}},
+		{"example-.com", IDN{}},
+		{"ab--cd.com", IDN{}},
+		{"exa_mple.com", IDN{}},
+		{"exa mple.com", IDN{}},
+		{"example..com", IDN{}},
+		{"ex♥ample.com", IDN{}},
+		{"xn--zz.example", IDN{}},
+		{strings.Repeat("a", 64) + ".com", IDN{}},
+		{strings.Repeat("abcdefghi.", 26) + "com", IDN{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			out := v.StrictDomain("k", tt.in)
+
+			want := map[string][]string{}
+			if tt.want == (IDN{}) && tt.in != "" {
+				want["k"] = []string{"must be a valid domain"}
+			}
+			if !reflect.DeepEqual(v.Errors, want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, want)
+			}
+			if out != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+		})
+	}
+}
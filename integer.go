@@ -0,0 +1,258 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// IntegerOptions are the options for the IntegerWithOptions() and
+// UnsignedInteger() validators.
+type IntegerOptions struct {
+	// Size of the integer type in bits (8, 16, 32, or 64); the default is 64.
+	// Values that don't fit are reported as out of range.
+	BitSize int
+
+	// Minimum and maximum value; the default of 0 means there is no limit.
+	Min, Max int64
+
+	// Separators to allow between digits, e.g. "," to accept "1,000" or ",_ "
+	// to also accept "1_000" and "1 000".
+	Separators string
+
+	// Accept exponents such as "1e3" or "2.5E2", as long as the value is a
+	// whole number.
+	AllowExponent bool
+}
+
+func (opts IntegerOptions) bitSize() int {
+	if opts.BitSize == 0 {
+		return 64
+	}
+	return opts.BitSize
+}
+
+var (
+	errIntegerSyntax = errors.New("syntax")
+	errIntegerRange  = errors.New("range")
+)
+
+var reIntegerExponent = regexp.MustCompile(`^[-+]?(?:\d+(?:\.\d*)?|\.\d+)[eE][-+]?\d+$`)
+
+// normalizeInteger removes the separators and expands the exponent, so the
+// value can be parsed with strconv.
+func normalizeInteger(value string, opts IntegerOptions) (string, error) {
+	value = strings.TrimSpace(value)
+
+	if opts.Separators != "" && strings.ContainsAny(value, opts.Separators) {
+		var b strings.Builder
+		for i, c := range value {
+			if !strings.ContainsRune(opts.Separators, c) {
+				b.WriteRune(c)
+				continue
+			}
+			// Only between two digits.
+			end := i + utf8.RuneLen(c)
+			if i == 0 || end >= len(value) || !isDigit(value[i-1]) || !isDigit(value[end]) {
+				return "", errIntegerSyntax
+			}
+		}
+		value = b.String()
+	}
+
+	if opts.AllowExponent && reIntegerExponent.MatchString(value) {
+		i := strings.IndexAny(value, "eE")
+		exp, err := strconv.Atoi(value[i+1:])
+		switch {
+		case err != nil || exp < -30:
+			return "", errIntegerSyntax
+		case exp > 30:
+			return "", errIntegerRange
+		}
+		r, ok := new(big.Rat).SetString(value)
+		if !ok || !r.IsInt() {
+			return "", errIntegerSyntax
+		}
+		value = r.Num().String()
+	}
+	return value, nil
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+// integerError gets the message for an error from normalizeInteger() or
+// strconv.
+func integerError(err error, signed bool, bitSize int) string {
+	if !errors.Is(err, errIntegerRange) && !errors.Is(err, strconv.ErrRange) {
+		return MessageInteger
+	}
+	if signed {
+		max := int64(^uint64(0) >> (65 - bitSize))
+		return fmt.Sprintf(MessageIntegerRange, strconv.FormatInt(-max-1, 10), strconv.FormatInt(max, 10))
+	}
+	max := ^uint64(0) >> (64 - bitSize)
+	return fmt.Sprintf(MessageIntegerRange, "0", strconv.FormatUint(max, 10))
+}
+
+// IntegerWithOptions checks if this looks like an integer (i.e. a whole
+// number), with the options.
+//
+// Values that are not a whole number are reported with MessageInteger, and
+// values that don't fit in the BitSize with MessageIntegerRange.
+//
+// The parsed value is returned; it's safe to convert this to the integer type
+// of BitSize.
+func (v *Validator) IntegerWithOptions(key, value string, opts IntegerOptions, message ...string) int64 {
+	if value == "" {
+		return 0
+	}
+
+	i, m := parseInteger(value, opts)
+	if m != "" {
+		v.Append(key, getMessage(message, m))
+	}
+	return i
+}
+
+// parseInteger parses the value with the options, returning the error message
+// if it's invalid or out of bounds.
+func parseInteger(value string, opts IntegerOptions) (int64, string) {
+	s, err := normalizeInteger(value, opts)
+	var i int64
+	if err == nil {
+		i, err = strconv.ParseInt(s, 10, opts.bitSize())
+	}
+	if err != nil {
+		return 0, integerError(err, true, opts.bitSize())
+	}
+
+	switch {
+	case opts.Min != 0 && i < opts.Min:
+		return i, fmt.Sprintf(MessageRangeHigher, opts.Min)
+	case opts.Max != 0 && i > opts.Max:
+		return i, fmt.Sprintf(MessageRangeLower, opts.Max)
+	}
+	return i, ""
+}
+
+// UnsignedInteger checks if this looks like a non-negative integer, with the
+// options.
+//
+// Negative values are reported with MessageNegative, and other errors as in
+// IntegerWithOptions().
+//
+// The parsed value is returned; it's safe to convert this to the unsigned
+// integer type of BitSize.
+func (v *Validator) UnsignedInteger(key, value string, opts IntegerOptions, message ...string) uint64 {
+	if value == "" {
+		return 0
+	}
+
+	s, err := normalizeInteger(value, opts)
+	if err == nil && strings.HasPrefix(s, "-") {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if i < 0 || errors.Is(err, strconv.ErrRange) {
+			v.Append(key, getMessage(message, MessageNegative))
+			return 0
+		}
+		if err == nil {
+			s = "0"
+		}
+	}
+
+	var u uint64
+	if err == nil {
+		u, err = strconv.ParseUint(strings.TrimPrefix(s, "+"), 10, opts.bitSize())
+	}
+	if err != nil {
+		v.Append(key, getMessage(message, integerError(err, false, opts.bitSize())))
+		return 0
+	}
+
+	switch {
+	case opts.Min > 0 && u < uint64(opts.Min):
+		v.Append(key, getMessage(message, fmt.Sprintf(MessageRangeHigher, opts.Min)))
+	case opts.Max > 0 && u > uint64(opts.Max):
+		v.Append(key, getMessage(message, fmt.Sprintf(MessageRangeLower, opts.Max)))
+	}
+	return u
+}
+
+// IntegerListOptions are the options for the IntegerList() validator.
+type IntegerListOptions struct {
+	// Options for every integer in the list.
+	IntegerOptions
+
+	// Separators between the integers in a value; the default is ",". This
+	// shouldn't overlap with IntegerOptions.Separators.
+	ListSeparators string
+
+	// Maximum number of integers; the default of 0 means there is no limit.
+	MaxCount int
+
+	// Remove integers that occur more than once, instead of reporting them as
+	// a duplicate.
+	Deduplicate bool
+}
+
+// IntegerList validates that the values are lists of integers, such as the
+// "ids=1,2,3" or repeated "ids=1&ids=2" query parameters, with the options.
+//
+// Every value is split on the ListSeparators, and empty elements are ignored.
+// Errors for an integer are added as "key[n]", where n is the index of the
+// non-empty element across all values.
+//
+// The valid integers are returned in the order they appear.
+func (v *Validator) IntegerList(key string, values []string, opts IntegerListOptions, message ...string) []int64 {
+	msg := getMessage(message, "")
+	appendMsg := func(p Path, m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.AppendPath(p, m)
+	}
+
+	sep := opts.ListSeparators
+	if sep == "" {
+		sep = ","
+	}
+	var elems []string
+	for _, value := range values {
+		for _, e := range strings.FieldsFunc(value, func(c rune) bool { return strings.ContainsRune(sep, c) }) {
+			if strings.TrimSpace(e) != "" {
+				elems = append(elems, e)
+			}
+		}
+	}
+	if opts.MaxCount > 0 && len(elems) > opts.MaxCount {
+		appendMsg(Path{PathField(key)}, fmt.Sprintf(MessageIntegerListMax, opts.MaxCount))
+	}
+
+	var (
+		list []int64
+		seen = make(map[int64]bool, len(elems))
+	)
+	for n, e := range elems {
+		p := Path{PathField(key), PathIndex(strconv.Itoa(n))}
+
+		i, m := parseInteger(e, opts.IntegerOptions)
+		if m != "" {
+			appendMsg(p, m)
+			continue
+		}
+		if seen[i] {
+			if !opts.Deduplicate {
+				appendMsg(p, MessageIntegerListDuplicate)
+			}
+			continue
+		}
+		seen[i] = true
+		list = append(list, i)
+	}
+
+	return list
+}
@@ -0,0 +1,157 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestIntegerWithOptions(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    IntegerOptions
+		want    int64
+		wantErr []string
+	}{
+		{"", IntegerOptions{}, 0, nil},
+		{"42", IntegerOptions{}, 42, nil},
+		{" -42 ", IntegerOptions{}, -42, nil},
+		{"+42", IntegerOptions{}, 42, nil},
+		{"4.2", IntegerOptions{}, 0, []string{MessageInteger}},
+		{"abc", IntegerOptions{}, 0, []string{MessageInteger}},
+		{"99999999999999999999", IntegerOptions{}, 0,
+			[]string{fmt.Sprintf(MessageIntegerRange, "-9223372036854775808", "9223372036854775807")}},
+
+		// Bit size.
+		{"127", IntegerOptions{BitSize: 8}, 127, nil},
+		{"128", IntegerOptions{BitSize: 8}, 0, []string{fmt.Sprintf(MessageIntegerRange, "-128", "127")}},
+		{"-2147483649", IntegerOptions{BitSize: 32}, 0,
+			[]string{fmt.Sprintf(MessageIntegerRange, "-2147483648", "2147483647")}},
+
+		// Bounds.
+		{"5", IntegerOptions{Min: 10}, 5, []string{fmt.Sprintf(MessageRangeHigher, 10)}},
+		{"-5", IntegerOptions{Min: -10, Max: 10}, -5, nil},
+		{"11", IntegerOptions{Max: 10}, 11, []string{fmt.Sprintf(MessageRangeLower, 10)}},
+
+		// Separators.
+		{"1,000", IntegerOptions{}, 0, []string{MessageInteger}},
+		{"1,000", IntegerOptions{Separators: ","}, 1000, nil},
+		{"-1,000,000", IntegerOptions{Separators: ","}, -1000000, nil},
+		{"1_000 000", IntegerOptions{Separators: ",_ "}, 1000000, nil},
+		{"1 000", IntegerOptions{Separators: " "}, 1000, nil},
+		{",100", IntegerOptions{Separators: ","}, 0, []string{MessageInteger}},
+		{"100,", IntegerOptions{Separators: ","}, 0, []string{MessageInteger}},
+		{"1,,000", IntegerOptions{Separators: ","}, 0, []string{MessageInteger}},
+		{"-,100", IntegerOptions{Separators: ","}, 0, []string{MessageInteger}},
+
+		// Exponents.
+		{"1e3", IntegerOptions{}, 0, []string{MessageInteger}},
+		{"1e3", IntegerOptions{AllowExponent: true}, 1000, nil},
+		{"2.5E2", IntegerOptions{AllowExponent: true}, 250, nil},
+		{"-1e+2", IntegerOptions{AllowExponent: true}, -100, nil},
+		{"1.5e0", IntegerOptions{AllowExponent: true}, 0, []string{MessageInteger}},
+		{"1e-3", IntegerOptions{AllowExponent: true}, 0, []string{MessageInteger}},
+		{"1e99999999", IntegerOptions{AllowExponent: true}, 0,
+			[]string{fmt.Sprintf(MessageIntegerRange, "-9223372036854775808", "9223372036854775807")}},
+		{"1e3", IntegerOptions{AllowExponent: true, BitSize: 8}, 0,
+			[]string{fmt.Sprintf(MessageIntegerRange, "-128", "127")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			got := v.IntegerWithOptions("i", tt.in, tt.opts)
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["i"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["i"], tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnsignedInteger(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    IntegerOptions
+		message []string
+		want    uint64
+		wantErr []string
+	}{
+		{"", IntegerOptions{}, nil, 0, nil},
+		{"42", IntegerOptions{}, nil, 42, nil},
+		{"+42", IntegerOptions{}, nil, 42, nil},
+		{"-0", IntegerOptions{}, nil, 0, nil},
+		{"18446744073709551615", IntegerOptions{}, nil, 18446744073709551615, nil},
+		{"18446744073709551616", IntegerOptions{}, nil, 0,
+			[]string{fmt.Sprintf(MessageIntegerRange, "0", "18446744073709551615")}},
+		{"-5", IntegerOptions{}, nil, 0, []string{MessageNegative}},
+		{"-99999999999999999999", IntegerOptions{}, nil, 0, []string{MessageNegative}},
+		{"-5", IntegerOptions{}, []string{"oops"}, 0, []string{"oops"}},
+		{"x", IntegerOptions{}, nil, 0, []string{MessageInteger}},
+		{"65536", IntegerOptions{BitSize: 16}, nil, 0, []string{fmt.Sprintf(MessageIntegerRange, "0", "65535")}},
+		{"65,535", IntegerOptions{BitSize: 16, Separators: ","}, nil, 65535, nil},
+		{"5", IntegerOptions{Min: 10}, nil, 5, []string{fmt.Sprintf(MessageRangeHigher, 10)}},
+		{"11", IntegerOptions{Max: 10}, nil, 11, []string{fmt.Sprintf(MessageRangeLower, 10)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			got := v.UnsignedInteger("i", tt.in, tt.opts, tt.message...)
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["i"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["i"], tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIntegerList(t *testing.T) {
+	tests := []struct {
+		in         []string
+		opts       IntegerListOptions
+		want       []int64
+		wantErrors map[string][]string
+	}{
+		{nil, IntegerListOptions{}, nil, map[string][]string{}},
+		{[]string{""}, IntegerListOptions{}, nil, map[string][]string{}},
+		{[]string{"1,2,3"}, IntegerListOptions{}, []int64{1, 2, 3}, map[string][]string{}},
+		{[]string{"1", "2", "3"}, IntegerListOptions{}, []int64{1, 2, 3}, map[string][]string{}},
+		{[]string{"1, 2", "3,", ",4"}, IntegerListOptions{}, []int64{1, 2, 3, 4}, map[string][]string{}},
+		{[]string{"1 2;3"}, IntegerListOptions{ListSeparators: " ;"}, []int64{1, 2, 3}, map[string][]string{}},
+		{[]string{"1,x", "3.5"}, IntegerListOptions{}, []int64{1},
+			map[string][]string{"k[1]": {MessageInteger}, "k[2]": {MessageInteger}}},
+		{[]string{"1,,x"}, IntegerListOptions{}, []int64{1},
+			map[string][]string{"k[1]": {MessageInteger}}},
+		{[]string{"1,2,1"}, IntegerListOptions{}, []int64{1, 2},
+			map[string][]string{"k[2]": {MessageIntegerListDuplicate}}},
+		{[]string{"1,2", "1"}, IntegerListOptions{Deduplicate: true}, []int64{1, 2}, map[string][]string{}},
+		{[]string{"1,2,3"}, IntegerListOptions{MaxCount: 2}, []int64{1, 2, 3},
+			map[string][]string{"k": {fmt.Sprintf(MessageIntegerListMax, 2)}}},
+		{[]string{"1,2", "3"}, IntegerListOptions{MaxCount: 3}, []int64{1, 2, 3}, map[string][]string{}},
+		{[]string{"0,5,11"}, IntegerListOptions{IntegerOptions: IntegerOptions{Min: 1, Max: 10}}, []int64{5},
+			map[string][]string{
+				"k[0]": {fmt.Sprintf(MessageRangeHigher, 1)},
+				"k[2]": {fmt.Sprintf(MessageRangeLower, 10)},
+			}},
+		{[]string{"127;128"}, IntegerListOptions{ListSeparators: ";", IntegerOptions: IntegerOptions{BitSize: 8}},
+			[]int64{127}, map[string][]string{"k[1]": {fmt.Sprintf(MessageIntegerRange, "-128", "127")}}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			out := v.IntegerList("k", tt.in, tt.opts)
+			if !reflect.DeepEqual(out, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors, tt.wantErrors) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
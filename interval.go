@@ -0,0 +1,178 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is a period between two times.
+//
+// Intervals are half-open: the End is not part of the interval, so an interval
+// ending at 10:00 doesn't overlap with one starting at 10:00.
+type Interval struct {
+	Start, End time.Time
+}
+
+// Duration gets the length of the interval.
+func (i Interval) Duration() time.Duration { return i.End.Sub(i.Start) }
+
+// Overlaps reports if the intervals have any time in common.
+func (i Interval) Overlaps(o Interval) bool {
+	return i.Start.Before(o.End) && o.Start.Before(i.End)
+}
+
+// IntervalOptions are the options for the Interval() and IntervalTimes()
+// validators.
+type IntervalOptions struct {
+	// Options for parsing the start and end; the bounds apply to both.
+	DateTimeOptions
+
+	// Minimum and maximum duration; the default of 0 means there is no limit.
+	MinDuration, MaxDuration time.Duration
+}
+
+// Interval validates that the start and end are valid dates (see DateTime()),
+// that the start is before or equal to the end, and that the duration between
+// them is within the bounds of the options.
+//
+// Errors for parsing the dates are added to their respective keys, and errors
+// about the interval are added to endKey.
+//
+// The parsed interval is returned. Either of the times may be zero if the value
+// is empty or can't be parsed.
+func (v *Validator) Interval(startKey, endKey, start, end string, opts IntervalOptions, message ...string) Interval {
+	i := Interval{
+		Start: v.DateTime(startKey, start, opts.DateTimeOptions, message...),
+		End:   v.DateTime(endKey, end, opts.DateTimeOptions, message...),
+	}
+	if i.Start.IsZero() || i.End.IsZero() {
+		return i
+	}
+
+	if m := checkInterval(i, opts); m != "" {
+		v.Append(endKey, getMessage(message, m))
+	}
+	return i
+}
+
+// IntervalTimes validates that the start is before or equal to the end, and
+// that the duration between them is within the bounds of the options.
+//
+// The Layouts and Location options are only used for formatting the bounds in
+// errors.
+func (v *Validator) IntervalTimes(key string, start, end time.Time, opts IntervalOptions, message ...string) {
+	if start.IsZero() || end.IsZero() {
+		return
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+	}
+
+	layout := time.RFC3339
+	if len(opts.Layouts) > 0 {
+		layout = opts.Layouts[0]
+	}
+	loc := opts.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	if !opts.Min.IsZero() && start.Before(opts.Min) {
+		appendMsg(fmt.Sprintf(MessageDateMin, opts.Min.In(loc).Format(layout)))
+	}
+	if !opts.Max.IsZero() && end.After(opts.Max) {
+		appendMsg(fmt.Sprintf(MessageDateMax, opts.Max.In(loc).Format(layout)))
+	}
+
+	if m := checkInterval(Interval{start, end}, opts); m != "" {
+		appendMsg(m)
+	}
+}
+
+// checkInterval checks the order and duration of the interval, returning the
+// error message if it fails.
+func checkInterval(i Interval, opts IntervalOptions) string {
+	d := i.Duration()
+	switch {
+	case d < 0:
+		return MessageIntervalOrder
+	case opts.MinDuration > 0 && d < opts.MinDuration:
+		return fmt.Sprintf(MessageIntervalMin, formatDuration(opts.MinDuration))
+	case opts.MaxDuration > 0 && d > opts.MaxDuration:
+		return fmt.Sprintf(MessageIntervalMax, formatDuration(opts.MaxDuration))
+	}
+	return ""
+}
+
+// IntervalsOverlap validates that none of the intervals overlap.
+//
+// Errors are added as "key[n]", where n is the index of the interval in the
+// list, and mention the interval it overlaps with. Intervals with a zero start
+// or end are ignored.
+func (v *Validator) IntervalsOverlap(key string, intervals []Interval, message ...string) {
+	order := make([]int, 0, len(intervals))
+	for n, i := range intervals {
+		if !i.Start.IsZero() && !i.End.IsZero() {
+			order = append(order, n)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return intervals[order[a]].Start.Before(intervals[order[b]].Start)
+	})
+
+	// The interval with the latest end so far; every interval that starts
+	// before that overlaps with it.
+	latest := -1
+	for _, n := range order {
+		if latest > -1 && intervals[n].Start.Before(intervals[latest].End) {
+			other := Path{PathField(key), PathIndex(strconv.Itoa(latest))}
+			v.AppendPath(Path{PathField(key), PathIndex(strconv.Itoa(n))},
+				getMessage(message, fmt.Sprintf(MessageIntervalOverlap, other)))
+		}
+		if latest == -1 || intervals[n].End.After(intervals[latest].End) {
+			latest = n
+		}
+	}
+}
+
+// formatDuration formats the duration in words, such as "1 day 2 hours" or
+// "90 seconds".
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		return "-" + formatDuration(-d)
+	}
+	if d < time.Second {
+		return d.String()
+	}
+
+	units := []struct {
+		d    time.Duration
+		name string
+	}{
+		{24 * time.Hour, "day"},
+		{time.Hour, "hour"},
+		{time.Minute, "minute"},
+		{time.Second, "second"},
+	}
+	var parts []string
+	for _, u := range units {
+		n := d / u.d
+		if n == 0 {
+			continue
+		}
+		d -= n * u.d
+		if n == 1 {
+			parts = append(parts, "1 "+u.name)
+		} else {
+			parts = append(parts, fmt.Sprintf("%d %ss", n, u.name))
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,142 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestInterval(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2020, 6, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		start, end string
+		opts       IntervalOptions
+		want       Interval
+		wantErr    map[string][]string
+	}{
+		{"", "", IntervalOptions{}, Interval{}, map[string][]string{}},
+		{"2020-06-01", "", IntervalOptions{}, Interval{Start: day(1)}, map[string][]string{}},
+		{"2020-06-01", "2020-06-01", IntervalOptions{}, Interval{day(1), day(1)}, map[string][]string{}},
+		{"2020-06-01", "2020-06-05", IntervalOptions{}, Interval{day(1), day(5)}, map[string][]string{}},
+		{"x", "2020-06-05", IntervalOptions{}, Interval{End: day(5)},
+			map[string][]string{"start": {MessageDateTime}}},
+		{"2020-06-05", "2020-06-01", IntervalOptions{}, Interval{day(5), day(1)},
+			map[string][]string{"end": {MessageIntervalOrder}}},
+		{"2020-06-01", "2020-06-05", IntervalOptions{MaxDuration: 72 * time.Hour}, Interval{day(1), day(5)},
+			map[string][]string{"end": {fmt.Sprintf(MessageIntervalMax, "3 days")}}},
+		{"2020-06-01", "2020-06-05", IntervalOptions{MinDuration: 7 * 24 * time.Hour}, Interval{day(1), day(5)},
+			map[string][]string{"end": {fmt.Sprintf(MessageIntervalMin, "7 days")}}},
+		{"2020-06-01", "2020-06-05", IntervalOptions{DateTimeOptions: DateTimeOptions{Max: day(3)}},
+			Interval{day(1), day(5)},
+			map[string][]string{"end": {fmt.Sprintf(MessageDateMax, "2020-06-03")}}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			got := v.Interval("start", "end", tt.start, tt.end, tt.opts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors, tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIntervalTimes(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2020, 6, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		start, end time.Time
+		opts       IntervalOptions
+		message    []string
+		wantErr    []string
+	}{
+		{time.Time{}, day(1), IntervalOptions{}, nil, nil},
+		{day(1), day(2), IntervalOptions{}, nil, nil},
+		{day(2), day(1), IntervalOptions{}, nil, []string{MessageIntervalOrder}},
+		{day(2), day(1), IntervalOptions{}, []string{"oops"}, []string{"oops"}},
+		{day(1), day(2), IntervalOptions{MaxDuration: 90 * time.Minute}, nil,
+			[]string{fmt.Sprintf(MessageIntervalMax, "1 hour 30 minutes")}},
+		{day(1), day(5), IntervalOptions{DateTimeOptions: DateTimeOptions{
+			Min: day(2), Max: day(4), Layouts: []string{"2006-01-02"},
+		}}, nil, []string{
+			fmt.Sprintf(MessageDateMin, "2020-06-02"),
+			fmt.Sprintf(MessageDateMax, "2020-06-04"),
+		}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			v.IntervalTimes("period", tt.start, tt.end, tt.opts, tt.message...)
+			if !reflect.DeepEqual(v.Errors["period"], tt.wantErr) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors["period"], tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIntervalsOverlap(t *testing.T) {
+	iv := func(start, end int) Interval {
+		return Interval{
+			time.Date(2020, 6, 1, start, 0, 0, 0, time.UTC),
+			time.Date(2020, 6, 1, end, 0, 0, 0, time.UTC),
+		}
+	}
+
+	tests := []struct {
+		in   []Interval
+		want map[string][]string
+	}{
+		{nil, map[string][]string{}},
+		{[]Interval{iv(9, 10), iv(10, 11), iv(12, 13)}, map[string][]string{}},
+		{[]Interval{iv(9, 11), iv(10, 12)}, map[string][]string{
+			"p[1]": {fmt.Sprintf(MessageIntervalOverlap, "p[0]")},
+		}},
+		{[]Interval{iv(14, 15), iv(9, 17), iv(12, 13)}, map[string][]string{
+			"p[0]": {fmt.Sprintf(MessageIntervalOverlap, "p[1]")},
+			"p[2]": {fmt.Sprintf(MessageIntervalOverlap, "p[1]")},
+		}},
+		{[]Interval{iv(9, 10), {}, iv(9, 10)}, map[string][]string{
+			"p[2]": {fmt.Sprintf(MessageIntervalOverlap, "p[0]")},
+		}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			v.IntervalsOverlap("p", tt.in)
+			if !reflect.DeepEqual(v.Errors, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{500 * time.Millisecond, "500ms"},
+		{time.Second, "1 second"},
+		{90 * time.Second, "1 minute 30 seconds"},
+		{26 * time.Hour, "1 day 2 hours"},
+		{-2 * time.Hour, "-2 hours"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := formatDuration(tt.in)
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package validate
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// IPRange is a set of special-purpose IP address ranges, for use in IPOptions.
+type IPRange uint
+
+// Special-purpose IP address ranges; see RFC 6890 and the IANA special-purpose
+// address registries.
+const (
+	// 127.0.0.0/8, ::1/128
+	IPLoopback IPRange = 1 << iota
+
+	// 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16 (RFC 1918), fc00::/7 (RFC
+	// 4193).
+	IPPrivate
+
+	// 169.254.0.0/16, fe80::/10
+	IPLinkLocal
+
+	// 224.0.0.0/4, ff00::/8
+	IPMulticast
+
+	// 192.0.2.0/24, 198.51.100.0/24, 203.0.113.0/24 (RFC 5737), 2001:db8::/32
+	// (RFC 3849).
+	IPDocumentation
+
+	// 0.0.0.0, ::
+	IPUnspecified
+
+	// Other ranges which aren't globally reachable: 0.0.0.0/8, 100.64.0.0/10
+	// (shared address space), 192.0.0.0/24 (IETF protocol assignments),
+	// 192.88.99.0/24 (deprecated 6to4 relay anycast), 198.18.0.0/15
+	// (benchmarking), 240.0.0.0/4, 255.255.255.255, 64:ff9b:1::/48 (local-use
+	// NAT64), 100::/64 (discard-only), 2001::/23 (IETF protocol assignments),
+	// fec0::/10 (deprecated site-local).
+	IPReserved
+
+	// IPSpecial is all of the above; rejecting this allows only globally
+	// reachable unicast addresses.
+	IPSpecial = IPLoopback | IPPrivate | IPLinkLocal | IPMulticast |
+		IPDocumentation | IPUnspecified | IPReserved
+)
+
+var ipRangeNames = []struct {
+	r    IPRange
+	name string
+}{
+	{IPLoopback, "loopback"},
+	{IPPrivate, "private"},
+	{IPLinkLocal, "link-local"},
+	{IPMulticast, "multicast"},
+	{IPDocumentation, "documentation"},
+	{IPUnspecified, "unspecified"},
+	{IPReserved, "reserved"},
+}
+
+// String gets a description of the ranges, e.g. "private or loopback".
+func (r IPRange) String() string {
+	var names []string
+	for _, n := range ipRangeNames {
+		if r&n.r != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return strings.Join(names, " or ")
+}
+
+var ipRanges = []struct {
+	prefix netip.Prefix
+	r      IPRange
+}{
+	{netip.MustParsePrefix("0.0.0.0/32"), IPUnspecified},
+	{netip.MustParsePrefix("0.0.0.0/8"), IPReserved},
+	{netip.MustParsePrefix("10.0.0.0/8"), IPPrivate},
+	{netip.MustParsePrefix("100.64.0.0/10"), IPReserved},
+	{netip.MustParsePrefix("127.0.0.0/8"), IPLoopback},
+	{netip.MustParsePrefix("169.254.0.0/16"), IPLinkLocal},
+	{netip.MustParsePrefix("172.16.0.0/12"), IPPrivate},
+	{netip.MustParsePrefix("192.0.0.0/24"), IPReserved},
+	{netip.MustParsePrefix("192.0.2.0/24"), IPDocumentation},
+	{netip.MustParsePrefix("192.88.99.0/24"), IPReserved},
+	{netip.MustParsePrefix("192.168.0.0/16"), IPPrivate},
+	{netip.MustParsePrefix("198.18.0.0/15"), IPReserved},
+	{netip.MustParsePrefix("198.51.100.0/24"), IPDocumentation},
+	{netip.MustParsePrefix("203.0.113.0/24"), IPDocumentation},
+	{netip.MustParsePrefix("224.0.0.0/4"), IPMulticast},
+	{netip.MustParsePrefix("240.0.0.0/4"), IPReserved},
+
+	{netip.MustParsePrefix("::/128"), IPUnspecified},
+	{netip.MustParsePrefix("::1/128"), IPLoopback},
+	{netip.MustParsePrefix("64:ff9b:1::/48"), IPReserved},
+	{netip.MustParsePrefix("100::/64"), IPReserved},
+	{netip.MustParsePrefix("2001::/23"), IPReserved},
+	{netip.MustParsePrefix("2001:db8::/32"), IPDocumentation},
+	{netip.MustParsePrefix("fc00::/7"), IPPrivate},
+	{netip.MustParsePrefix("fec0::/10"), IPReserved},
+	{netip.MustParsePrefix("fe80::/10"), IPLinkLocal},
+	{netip.MustParsePrefix("ff00::/8"), IPMulticast},
+}
+
+// IPv6 ranges which embed an IPv4 address, as the address can be used to reach
+// that IPv4 address; e.g. 64:ff9b::a9fe:a9fe is 169.254.169.254.
+var ipEmbedded = []struct {
+	prefix netip.Prefix
+	offset int // Offset of the IPv4 address in bytes.
+}{
+	{netip.MustParsePrefix("64:ff9b::/96"), 12}, // NAT64 (RFC 6052)
+	{netip.MustParsePrefix("2002::/16"), 2},     // 6to4 (RFC 3056)
+	{netip.MustParsePrefix("::/96"), 12},        // IPv4-compatible (RFC 4291)
+}
+
+// Add the IPv4 ranges for every IPv6 range that embeds an IPv4 address.
+func init() {
+	ranges := ipRanges
+	for _, e := range ipEmbedded {
+		for _, rr := range ranges {
+			if !rr.prefix.Addr().Is4() {
+				continue
+			}
+			v4 := rr.prefix.Addr().As4()
+			// :: and ::1 are already listed, and aren't IPv4-compatible.
+			if e.prefix.Addr().IsUnspecified() && v4[0] == 0 {
+				continue
+			}
+
+			b := e.prefix.Addr().As16()
+			copy(b[e.offset:], v4[:])
+			rr.prefix = netip.PrefixFrom(netip.AddrFrom16(b), e.offset*8+rr.prefix.Bits())
+			ipRanges = append(ipRanges, rr)
+		}
+	}
+}
+
+// ClassifyIP gets all special-purpose ranges the address is in, or 0 if it's a
+// globally reachable unicast address.
+//
+// IPv4-mapped IPv6 addresses (::ffff:127.0.0.1) are classified as the IPv4
+// address. IPv6 addresses which embed an IPv4 address (NAT64 64:ff9b::/96, 6to4
+// 2002::/16, and IPv4-compatible ::/96) are also in the ranges of the embedded
+// address.
+func ClassifyIP(addr netip.Addr) IPRange {
+	return classifyPrefix(netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), false)
+}
+
+// classifyPrefix gets all ranges that overlap with p, or all ranges that
+// contain p entirely if contained is set.
+func classifyPrefix(p netip.Prefix, contained bool) IPRange {
+	var r IPRange
+	for _, rr := range ipRanges {
+		if contained {
+			if rr.prefix.Bits() <= p.Bits() && rr.prefix.Contains(p.Addr()) {
+				r |= rr.r
+			}
+		} else if rr.prefix.Overlaps(p) {
+			r |= rr.r
+		}
+	}
+	return r
+}
+
+// IPOptions are the options for the IP() and CIDR() validators.
+type IPOptions struct {
+	// Only allow IPv4 (4) or IPv6 (6) addresses; the default of 0 allows both.
+	Version int
+
+	// Reject addresses in any of these ranges; e.g. IPPrivate|IPLoopback, or
+	// IPSpecial to only allow globally reachable addresses.
+	Reject IPRange
+
+	// Require that addresses are in one of these ranges; e.g. IPPrivate to
+	// allow only private network addresses.
+	Require IPRange
+
+	// Require that addresses are in one of these networks.
+	Allow []netip.Prefix
+
+	// Reject addresses in any of these networks.
+	Deny []netip.Prefix
+}
+
+// IP validates that a string is a valid IPv4 or IPv6 address, and that it's
+// allowed by the options.
+//
+// IPv6 addresses with a zone (fe80::1%eth0) are not accepted.
+func (v *Validator) IP(key, value string, opts IPOptions, message ...string) net.IP {
+	if value == "" {
+		return net.IP{}
+	}
+
+	msg := getMessage(message, "")
+	ip := net.ParseIP(value)
+	if ip == nil || !ipVersion(ip, opts.Version) {
+		v.Append(key, ipMessage(msg, opts.Version))
+		return ip
+	}
+
+	addr, _ := netip.AddrFromSlice(ip)
+	addr = addr.Unmap()
+	v.checkIPOptions(key, netip.PrefixFrom(addr, addr.BitLen()), opts, msg)
+	return ip
+}
+
+// IPv6 validates that a string is a valid IPv6 address.
+func (v *Validator) IPv6(key, value string, message ...string) net.IP {
+	if value == "" {
+		return net.IP{}
+	}
+
+	msg := getMessage(message, MessageIPv6)
+	ip := net.ParseIP(value)
+	if ip == nil || !ipVersion(ip, 6) {
+		v.Append(key, msg)
+	}
+	return ip
+}
+
+// CIDR validates that a string is a valid network in CIDR notation (e.g.
+// "192.168.1.0/24" or "2001:db8::/32"), and that it's allowed by the options.
+//
+// The Reject and Deny options reject networks that overlap with any of the
+// ranges, and the Require and Allow options require that the network is
+// contained entirely in one of them.
+//
+// Addresses with host bits set ("192.168.1.1/24") are accepted, and the
+// returned network is always masked ("192.168.1.0/24"). IPv4-mapped IPv6 networks
+// ("::ffff:10.0.0.0/104") are converted to IPv4 ("10.0.0.0/8").
+func (v *Validator) CIDR(key, value string, opts IPOptions, message ...string) *net.IPNet {
+	p := v.CIDRPrefix(key, value, opts, message...)
+	if !p.IsValid() {
+		return nil
+	}
+	return &net.IPNet{
+		IP:   p.Addr().AsSlice(),
+		Mask: net.CIDRMask(p.Bits(), p.Addr().BitLen()),
+	}
+}
+
+// CIDRPrefix is like CIDR(), but returns a netip.Prefix.
+func (v *Validator) CIDRPrefix(key, value string, opts IPOptions, message ...string) netip.Prefix {
+	if value == "" {
+		return netip.Prefix{}
+	}
+
+	msg := getMessage(message, "")
+	p, err := netip.ParsePrefix(value)
+	if err == nil {
+		p, err = unmapPrefix(p)
+	}
+	if err != nil || p.Addr().Zone() != "" || !prefixVersion(p, opts.Version) {
+		if msg == "" {
+			msg = MessageCIDR
+		}
+		v.Append(key, msg)
+		return netip.Prefix{}
+	}
+
+	p = p.Masked()
+	v.checkIPOptions(key, p, opts, msg)
+	return p
+}
+
+// unmapPrefix converts an IPv4-mapped IPv6 prefix (::ffff:10.0.0.0/104) to the
+// IPv4 prefix (10.0.0.0/8), so that it's checked against the IPv4 ranges.
+// Prefixes which contain both mapped and other IPv6 addresses are rejected.
+func unmapPrefix(p netip.Prefix) (netip.Prefix, error) {
+	if !p.Addr().Is4In6() {
+		return p, nil
+	}
+	if p.Bits() < 96 {
+		return netip.Prefix{}, fmt.Errorf("validate: IPv4-mapped prefix %s is shorter than 96 bits", p)
+	}
+	return netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96), nil
+}
+
+func ipVersion(ip net.IP, version int) bool {
+	switch version {
+	case 4:
+		return ip.To4() != nil
+	case 6:
+		return ip.To4() == nil
+	default:
+		return true
+	}
+}
+
+func prefixVersion(p netip.Prefix, version int) bool {
+	switch version {
+	case 4:
+		return p.Addr().Is4()
+	case 6:
+		return p.Addr().Is6()
+	default:
+		return true
+	}
+}
+
+func ipMessage(msg string, version int) string {
+	switch {
+	case msg != "":
+		return msg
+	case version == 4:
+		return MessageIPv4
+	case version == 6:
+		return MessageIPv6
+	default:
+		return MessageIP
+	}
+}
+
+func (v *Validator) checkIPOptions(key string, p netip.Prefix, opts IPOptions, msg string) {
+	appendMsg := func(m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+	}
+
+	if r := classifyPrefix(p, false) & opts.Reject; r != 0 {
+		appendMsg(fmt.Sprintf(MessageIPReject, r))
+		return
+	}
+	if opts.Require != 0 && classifyPrefix(p, true)&opts.Require == 0 {
+		appendMsg(fmt.Sprintf(MessageIPRequire, opts.Require))
+		return
+	}
+
+	for _, d := range opts.Deny {
+		if d, err := unmapPrefix(d); err == nil && d.Overlaps(p) {
+			appendMsg(MessageIPDeny)
+			return
+		}
+	}
+	if len(opts.Allow) == 0 {
+		return
+	}
+	for _, a := range opts.Allow {
+		if a, err := unmapPrefix(a); err == nil && a.Bits() <= p.Bits() && a.Contains(p.Addr()) {
+			return
+		}
+	}
+	appendMsg(MessageIPAllow)
+}
@@ -0,0 +1,203 @@
+package validate
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestClassifyIP(t *testing.T) {
+	tests := []struct {
+		in   string
+		want IPRange
+	}{
+		{"8.8.8.8", 0},
+		{"2606:4700::1111", 0},
+		{"127.0.0.1", IPLoopback},
+		{"::1", IPLoopback},
+		{"::ffff:127.0.0.1", IPLoopback},
+		{"10.1.2.3", IPPrivate},
+		{"172.31.255.255", IPPrivate},
+		{"172.32.0.1", 0},
+		{"192.168.1.1", IPPrivate},
+		{"fd12:3456::1", IPPrivate},
+		{"169.254.169.254", IPLinkLocal},
+		{"fe80::1", IPLinkLocal},
+		{"224.0.0.1", IPMulticast},
+		{"ff02::1", IPMulticast},
+		{"192.0.2.1", IPDocumentation},
+		{"2001:db8::1", IPDocumentation},
+		{"2001:1::1", IPReserved},
+		{"0.0.0.0", IPUnspecified | IPReserved},
+		{"::", IPUnspecified},
+		{"64:ff9b::a9fe:a9fe", IPLinkLocal},
+		{"64:ff9b::808:808", 0},
+		{"2002:7f00:1::", IPLoopback},
+		{"2002:a00:1::1", IPPrivate},
+		{"2002:808:808::1", 0},
+		{"::127.0.0.1", IPLoopback},
+		{"::192.168.1.1", IPPrivate},
+		{"100.64.0.1", IPReserved},
+		{"255.255.255.255", IPReserved},
+		{"192.88.99.1", IPReserved},
+		{"fec0::1", IPReserved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if out := ClassifyIP(netip.MustParseAddr(tt.in)); out != tt.want {
+				t.Errorf("\nout:  %s\nwant: %s\n", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestIP(t *testing.T) {
+	tests := []struct {
+		val        func(Validator)
+		wantErrors map[string][]string
+	}{
+		{
+			func(v Validator) {
+				v.IP("k", "", IPOptions{})
+				v.IP("k", "1.2.3.4", IPOptions{})
+				v.IP("k", "2001:4860::8888", IPOptions{})
+				v.IP("k", "10.0.0.1", IPOptions{Version: 4})
+				v.IPv6("k", "")
+				v.IPv6("k", "::1")
+			},
+			map[string][]string{},
+		},
+		{
+			func(v Validator) {
+				v.IP("k1", "1.2.3", IPOptions{})
+				v.IP("k2", "fe80::1%eth0", IPOptions{})
+				v.IP("k3", "::1", IPOptions{Version: 4})
+				v.IP("k4", "1.2.3.4", IPOptions{Version: 6})
+				v.IP("k5", "asd", IPOptions{}, "foo")
+				v.IPv6("k6", "1.2.3.4")
+			},
+			map[string][]string{
+				"k1": {"must be a valid IP address"},
+				"k2": {"must be a valid IP address"},
+				"k3": {"must be a valid IPv4 address"},
+				"k4": {"must be a valid IPv6 address"},
+				"k5": {"foo"},
+				"k6": {"must be a valid IPv6 address"},
+			},
+		},
+		{
+			func(v Validator) {
+				o := IPOptions{Reject: IPPrivate | IPLoopback}
+				v.IP("k1", "8.8.8.8", o)
+				v.IP("k2", "127.0.0.1", o)
+				v.IP("k3", "::ffff:192.168.1.1", o)
+				v.IP("k4", "fd00::1", o, "foo")
+				v.IP("k5", "0.0.0.0", IPOptions{Reject: IPSpecial})
+			},
+			map[string][]string{
+				"k2": {"cannot be in the loopback range"},
+				"k3": {"cannot be in the private range"},
+				"k4": {"foo"},
+				"k5": {"cannot be in the unspecified or reserved range"},
+			},
+		},
+		{
+			func(v Validator) {
+				o := IPOptions{Require: IPPrivate | IPLoopback}
+				v.IP("k1", "10.0.0.1", o)
+				v.IP("k2", "::1", o)
+				v.IP("k3", "8.8.8.8", o)
+			},
+			map[string][]string{
+				"k3": {"must be in the loopback or private range"},
+			},
+		},
+		{
+			func(v Validator) {
+				o := IPOptions{
+					Allow: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("2001:db8::/32")},
+					Deny:  []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")},
+				}
+				v.IP("k1", "10.0.0.1", o)
+				v.IP("k2", "2001:db8::1", o)
+				v.IP("k3", "11.0.0.1", o)
+				v.IP("k4", "10.1.2.3", o)
+			},
+			map[string][]string{
+				"k3": {"must be in an allowed network"},
+				"k4": {"cannot be in a blocked network"},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			tt.val(v)
+
+			if !reflect.DeepEqual(v.Errors, tt.wantErrors) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestCIDR(t *testing.T) {
+	tests := []struct {
+		in         string
+		opts       IPOptions
+		want       string
+		wantErrors map[string][]string
+	}{
+		{"", IPOptions{}, "<nil>", map[string][]string{}},
+		{"192.168.1.0/24", IPOptions{}, "192.168.1.0/24", map[string][]string{}},
+		{"192.168.1.1/24", IPOptions{}, "192.168.1.0/24", map[string][]string{}},
+		{"2001:db8::/32", IPOptions{}, "2001:db8::/32", map[string][]string{}},
+		{"192.168.1.0", IPOptions{}, "<nil>",
+			map[string][]string{"k": {"must be a valid network in CIDR notation"}}},
+		{"192.168.1.0/33", IPOptions{}, "<nil>",
+			map[string][]string{"k": {"must be a valid network in CIDR notation"}}},
+		{"2001:db8::/32", IPOptions{Version: 4}, "<nil>",
+			map[string][]string{"k": {"must be a valid network in CIDR notation"}}},
+
+		// Reject on overlap, require containment.
+		{"8.0.0.0/7", IPOptions{Reject: IPPrivate}, "8.0.0.0/7", map[string][]string{}},
+		{"8.0.0.0/6", IPOptions{Reject: IPPrivate}, "8.0.0.0/6",
+			map[string][]string{"k": {"cannot be in the private range"}}},
+		{"10.1.0.0/16", IPOptions{Require: IPPrivate}, "10.1.0.0/16", map[string][]string{}},
+		{"10.0.0.0/7", IPOptions{Require: IPPrivate}, "10.0.0.0/7",
+			map[string][]string{"k": {"must be in the private range"}}},
+		{"10.0.0.0/16", IPOptions{Allow: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/15")}},
+			"10.0.0.0/16", map[string][]string{}},
+		{"10.0.0.0/14", IPOptions{Allow: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/15")}},
+			"10.0.0.0/14", map[string][]string{"k": {"must be in an allowed network"}}},
+
+		// IPv4-mapped IPv6 is checked as IPv4.
+		{"::ffff:10.0.0.0/104", IPOptions{Reject: IPSpecial}, "10.0.0.0/8",
+			map[string][]string{"k": {"cannot be in the private range"}}},
+		{"::ffff:127.0.0.1/128", IPOptions{Reject: IPSpecial}, "127.0.0.1/32",
+			map[string][]string{"k": {"cannot be in the loopback range"}}},
+		{"::ffff:8.8.8.0/120", IPOptions{Deny: []netip.Prefix{netip.MustParsePrefix("8.8.0.0/16")}}, "8.8.8.0/24",
+			map[string][]string{"k": {"cannot be in a blocked network"}}},
+		{"::ffff:0:0/80", IPOptions{}, "<nil>",
+			map[string][]string{"k": {"must be a valid network in CIDR notation"}}},
+		{"fec0::/16", IPOptions{Reject: IPSpecial}, "fec0::/16",
+			map[string][]string{"k": {"cannot be in the reserved range"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			out := v.CIDR("k", tt.in, tt.opts)
+
+			if !reflect.DeepEqual(v.Errors, tt.wantErrors) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErrors)
+			}
+			if out.String() != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package validate
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// KeyMap rewrites the error keys for output, for example to report errors with
+// the snake_case names used in an API while validating with Go-style names.
+//
+// The mapping is applied by String(), MappedErrors(), and ErrorJSON(); the
+// Errors map itself is never modified, and json.Marshal() on a Validator uses
+// the Errors map as-is.
+type KeyMap struct {
+	// Rename keys; the map keys are the keys as stored in Errors (e.g.
+	// "addresses[0].city"). A renamed key is used as-is, and Prefix and Case
+	// are not applied.
+	Rename map[string]string
+
+	// Prefix rewrites keys that start with the given path (e.g. "settings" or
+	// "addresses[0]"); the longest matching prefix is used. Case is only
+	// applied to the remainder of the key.
+	Prefix map[string]string
+
+	// Case transforms every field name in the key; indexes are kept as-is.
+	// This can be one of CamelCase, SnakeCase, KebabCase, or any custom
+	// function.
+	Case func(string) string
+}
+
+// Key gets the output key for the key and its path.
+func (m KeyMap) Key(key string, p Path) string {
+	if r, ok := m.Rename[key]; ok {
+		return r
+	}
+
+	var (
+		head    Path
+		matched = -1
+	)
+	for from, to := range m.Prefix {
+		fp := pathOrField(from)
+		if len(fp) > matched && p.HasPrefix(fp) {
+			matched = len(fp)
+			head = pathOrField(to)
+		}
+	}
+	if matched > 0 {
+		p = p[matched:]
+	}
+
+	if m.Case != nil {
+		tail := make(Path, len(p))
+		for i, e := range p {
+			if !e.IsIndex {
+				e.Name = m.Case(e.Name)
+			}
+			tail[i] = e
+		}
+		p = tail
+	}
+	return head.Append(p...).String()
+}
+
+// pathOrField parses s as a path, falling back to a single field if it can't be
+// parsed.
+func pathOrField(s string) Path {
+	p, err := ParsePath(s)
+	if err != nil {
+		return Path{PathField(s)}
+	}
+	return p
+}
+
+// MapKeys sets the key mapping to use for output.
+func (v *Validator) MapKeys(m KeyMap) {
+	v.keyMap = &m
+}
+
+// MappedErrors gets the errors with the KeyMap set with MapKeys() applied.
+//
+// The Errors map is returned as-is if no KeyMap is set. Messages for keys that
+// are mapped to the same output key are combined, in the order of the original
+// keys.
+func (v *Validator) MappedErrors() map[string][]string {
+	if v.keyMap == nil {
+		return v.Errors
+	}
+
+	keys := make([]string, 0, len(v.Errors))
+	for k := range v.Errors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make(map[string][]string, len(v.Errors))
+	for _, k := range keys {
+		mk := v.keyMap.Key(k, v.Path(k))
+		out[mk] = append(out[mk], v.Errors[k]...)
+	}
+	return out
+}
+
+// CamelCase converts a name to camelCase: "first_name" becomes "firstName".
+func CamelCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		w = strings.ToLower(w)
+		if i > 0 {
+			r, n := utf8.DecodeRuneInString(w)
+			w = string(unicode.ToUpper(r)) + w[n:]
+		}
+		words[i] = w
+	}
+	return strings.Join(words, "")
+}
+
+// SnakeCase converts a name to snake_case: "firstName" becomes "first_name"
+// and "userID" becomes "user_id".
+func SnakeCase(s string) string {
+	return strings.ToLower(strings.Join(splitWords(s), "_"))
+}
+
+// KebabCase converts a name to kebab-case: "firstName" becomes "first-name".
+func KebabCase(s string) string {
+	return strings.ToLower(strings.Join(splitWords(s), "-"))
+}
+
+// splitWords splits a name in to words on underscores, hyphens, spaces, and
+// changes in case. A run of uppercase letters is kept together as an acronym:
+// "HTTPServer" is split as "HTTP", "Server".
+func splitWords(s string) []string {
+	var (
+		words []string
+		cur   []rune
+		rs    = []rune(s)
+	)
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, string(cur))
+			cur = cur[:0]
+		}
+	}
+
+	for i, r := range rs {
+		switch {
+		case r == '_' || r == '-' || unicode.IsSpace(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && len(cur) > 0:
+			prev := cur[len(cur)-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if !unicode.IsUpper(prev) || nextLower {
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+	return words
+}
@@ -0,0 +1,158 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCase(t *testing.T) {
+	tests := []struct {
+		in, camel, snake, kebab string
+	}{
+		{"", "", "", ""},
+		{"name", "name", "name", "name"},
+		{"firstName", "firstName", "first_name", "first-name"},
+		{"first_name", "firstName", "first_name", "first-name"},
+		{"first-name", "firstName", "first_name", "first-name"},
+		{"FirstName", "firstName", "first_name", "first-name"},
+		{"userID", "userId", "user_id", "user-id"},
+		{"HTTPServer", "httpServer", "http_server", "http-server"},
+		{"address2", "address2", "address2", "address2"},
+		{"ÉtéLong", "étéLong", "été_long", "été-long"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if out := CamelCase(tt.in); out != tt.camel {
+				t.Errorf("CamelCase\nout:  %#v\nwant: %#v\n", out, tt.camel)
+			}
+			if out := SnakeCase(tt.in); out != tt.snake {
+				t.Errorf("SnakeCase\nout:  %#v\nwant: %#v\n", out, tt.snake)
+			}
+			if out := KebabCase(tt.in); out != tt.kebab {
+				t.Errorf("KebabCase\nout:  %#v\nwant: %#v\n", out, tt.kebab)
+			}
+		})
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	newV := func() Validator {
+		v := New()
+		v.Required("firstName", "")
+		v.Append("emailAddress", "must be a valid email address")
+		addr := New()
+		addr.Required("zipCode", "")
+		v.Sub("homeAddresses", "0", addr)
+		settings := New()
+		settings.Required("notifyEmail", "")
+		v.Sub("userSettings", "", settings)
+		return v
+	}
+
+	tests := []struct {
+		in   KeyMap
+		want map[string][]string
+	}{
+		{
+			KeyMap{},
+			map[string][]string{
+				"firstName":                {"must be set"},
+				"emailAddress":             {"must be a valid email address"},
+				"homeAddresses[0].zipCode": {"must be set"},
+				"userSettings.notifyEmail": {"must be set"},
+			},
+		},
+		{
+			KeyMap{Case: SnakeCase},
+			map[string][]string{
+				"first_name":                 {"must be set"},
+				"email_address":              {"must be a valid email address"},
+				"home_addresses[0].zip_code": {"must be set"},
+				"user_settings.notify_email": {"must be set"},
+			},
+		},
+		{
+			KeyMap{
+				Case:   KebabCase,
+				Rename: map[string]string{"emailAddress": "mail", "firstName": "email_address"},
+				Prefix: map[string]string{"userSettings": "prefs", "homeAddresses[0]": "primaryAddress"},
+			},
+			map[string][]string{
+				"email_address":           {"must be set"},
+				"mail":                    {"must be a valid email address"},
+				"primaryAddress.zip-code": {"must be set"},
+				"prefs.notify-email":      {"must be set"},
+			},
+		},
+		{
+			KeyMap{Rename: map[string]string{"emailAddress": "firstName"}},
+			map[string][]string{
+				"firstName":                {"must be a valid email address", "must be set"},
+				"homeAddresses[0].zipCode": {"must be set"},
+				"userSettings.notifyEmail": {"must be set"},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := newV()
+			v.MapKeys(tt.in)
+			if d := cmp.Diff(v.MappedErrors(), tt.want); d != "" {
+				t.Errorf("(-got +want)\n:%s", d)
+			}
+		})
+	}
+
+	t.Run("output", func(t *testing.T) {
+		v := New()
+		v.Sub("homeAddress", "", func() error {
+			s := New()
+			s.Required("zipCode", "")
+			return s
+		}())
+		v.MapKeys(KeyMap{Case: SnakeCase})
+
+		wantStr := "home_address.zip_code: must be set.\n"
+		if out := v.String(); out != wantStr {
+			t.Errorf("String\nout:  %#v\nwant: %#v\n", out, wantStr)
+		}
+
+		wantJSON := `{"errors":{"home_address.zip_code":["must be set"]}}`
+		out, err := v.ErrorJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != wantJSON {
+			t.Errorf("ErrorJSON\nout:  %#v\nwant: %#v\n", string(out), wantJSON)
+		}
+
+		if _, ok := v.Errors["homeAddress.zipCode"]; !ok {
+			t.Errorf("Errors was modified: %#v", v.Errors)
+		}
+	})
+
+	// Structs embedding a Validator keep their own JSON encoding.
+	t.Run("embedded", func(t *testing.T) {
+		v := New()
+		v.Required("zipCode", "")
+		v.MapKeys(KeyMap{Case: SnakeCase})
+		e := struct {
+			Name string `json:"name"`
+			Validator
+		}{"x", v}
+
+		want := `{"name":"x","errors":{"zipCode":["must be set"]}}`
+		out, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != want {
+			t.Errorf("\nout:  %#v\nwant: %#v\n", string(out), want)
+		}
+	})
+}
@@ -2,11 +2,45 @@ package validate
 
 // Messages for the checkers; this can be changed for i18n.
 const (
-	MessageRequired    = "must be set"
-	MessageDomain      = "must be a valid domain"
+	MessageRequired = "must be set"
+	MessageDomain   = "must be a valid domain"
+
+	MessageDomainPublicSuffix  = "cannot be a public suffix"
+	MessageDomainPrivateSuffix = "cannot be a subdomain of ‘%s’"
+	MessageDomainRegistrable   = "must be a registrable domain, not a subdomain"
+	MessageDomainUnknownTLD    = "must have a known top-level domain"
+
 	MessageURL         = "must be a valid url"
+	MessageURLScheme   = "must use one of the schemes ‘%s’"
+	MessageURLUserinfo = "cannot contain a username or password"
+	MessageURLPort     = "cannot use port %s"
+	MessageURLInternal = "cannot point to an internal address"
+	MessageURLResolve  = "must have a host that can be resolved"
 	MessageEmail       = "must be a valid email address"
+
+	MessageEmailBare       = "must be an email address without a name"
+	MessageEmailLength     = "is too long for an email address"
+	MessageEmailASCII      = "cannot contain non-ASCII characters"
+	MessageEmailDisposable = "cannot be a disposable email address"
+	MessageEmailDomain     = "must have a valid email domain"
+
+	MessageEmailListMax       = "cannot have more than %d addresses"
+	MessageEmailListDomain    = "must be an address at ‘%s’"
+	MessageEmailListDuplicate = "is a duplicate address"
+
+	MessageEmailUndeliverable = "must have a domain that can receive email"
+	MessageEmailSuggest       = "must have a domain that can receive email; did you mean ‘%s’?"
+
 	MessageIPv4        = "must be a valid IPv4 address"
+	MessageIPv6        = "must be a valid IPv6 address"
+	MessageIP          = "must be a valid IP address"
+	MessageCIDR        = "must be a valid network in CIDR notation"
+	MessageIPReject    = "cannot be in the %s range"
+	MessageIPRequire   = "must be in the %s range"
+	MessageIPAllow     = "must be in an allowed network"
+	MessageIPDeny      = "cannot be in a blocked network"
+	MessageMixedScript = "cannot mix characters from different scripts (%s)"
+	MessageConfusable  = "looks too similar to ‘%s’"
 	MessageHexColor    = "must be a valid color code"
 	MessageLenLonger   = "must be longer than %d characters"
 	MessageLenShorter  = "must be shorter than %d characters"
@@ -18,6 +52,59 @@ const (
 	MessagePhone       = "must be a valid phone number"
 	MessageRangeHigher = "must be %d or higher"
 	MessageRangeLower  = "must be %d or lower"
+
+	MessageDateTime   = "must be a valid date"
+	MessageDateMin    = "must be on or after %s"
+	MessageDateMax    = "must be on or before %s"
+	MessageDatePast   = "cannot be in the past"
+	MessageDateFuture = "cannot be in the future"
+
+	MessageIntegerRange         = "must be between %s and %s"
+	MessageIntegerListMax       = "cannot have more than %d items"
+	MessageIntegerListDuplicate = "is a duplicate"
+
+	MessageFloat            = "must be a number"
+	MessageNegative         = "cannot be negative"
+	MessageNumberHigher     = "must be %s or higher"
+	MessageNumberLower      = "must be %s or lower"
+	MessageDecimal          = "must be a decimal number such as ‘12.34’"
+	MessageDecimalScale     = "cannot have more than %d decimal places"
+	MessageDecimalPrecision = "cannot have more than %d digits"
+
+	MessageCurrency        = "must be a valid ISO 4217 currency code"
+	MessageCurrencyAllowed = "must be one of the currencies ‘%s’"
+	MessageMoneyScale      = "cannot have more than %d decimal places for %s"
+	MessageMoneyWhole      = "must be a whole number for %s"
+
+	MessageTimeZone       = "must be a valid time zone"
+	MessageTimeOfDay      = "must be a time such as ‘17:30’ or ‘5:30 pm’"
+	MessageTimeOfDayOrder = "must be after the start time"
+
+	MessageDuration         = "must be a duration such as ‘1h30m’"
+	MessageDurationISO      = "must be an ISO 8601 duration such as ‘PT1H30M’"
+	MessageDurationCalendar = "cannot use years or months"
+	MessageDurationNegative = "cannot be negative"
+	MessageDurationMin      = "must be at least %s"
+	MessageDurationMax      = "must be at most %s"
+
+	MessageRRule          = "must be a valid recurrence rule (%s)"
+	MessageRRuleEnd       = "must have a COUNT or UNTIL"
+	MessageRRuleCount     = "cannot have more than %d occurrences"
+	MessageRRuleFrequency = "cannot repeat more often than %s"
+
+	MessageCron         = "must be a cron expression such as ‘0 9 * * MON’"
+	MessageCronField    = "has an invalid %s field ‘%s’"
+	MessageCronNever    = "will never run"
+	MessageCronInterval = "cannot run more often than every %s"
+
+	MessageIntervalOrder   = "must be on or after the start"
+	MessageIntervalMin     = "must be at least %s after the start"
+	MessageIntervalMax     = "must be at most %s after the start"
+	MessageIntervalOverlap = "overlaps with ‘%s’"
+
+	MessagePhoneCountryCode = "must be a phone number with a country code"
+	MessagePhoneCountry     = "must be a phone number from ‘%s’"
+	MessagePhoneType        = "must be a %s number"
 )
 
 func getMessage(in []string, def string) string {
@@ -0,0 +1,154 @@
+package validate
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Number of digits of the minor unit for all active ISO 4217 currencies, e.g.
+// 2 for EUR (cents), and 0 for JPY.
+//
+// Funds, precious metals, and testing codes are not included.
+var currencyMinorUnits = map[string]int{
+	// No minor unit.
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+
+	// Three and four digits.
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+
+	// Two digits.
+	"AED": 2, "AFN": 2, "ALL": 2, "AMD": 2, "ANG": 2, "AOA": 2, "ARS": 2,
+	"AUD": 2, "AWG": 2, "AZN": 2, "BAM": 2, "BBD": 2, "BDT": 2, "BGN": 2,
+	"BMD": 2, "BND": 2, "BOB": 2, "BOV": 2, "BRL": 2, "BSD": 2, "BTN": 2,
+	"BWP": 2, "BYN": 2, "BZD": 2, "CAD": 2, "CDF": 2, "CHE": 2, "CHF": 2,
+	"CHW": 2, "CNY": 2, "COP": 2, "COU": 2, "CRC": 2, "CUP": 2, "CVE": 2,
+	"CZK": 2, "DKK": 2, "DOP": 2, "DZD": 2, "EGP": 2, "ERN": 2, "ETB": 2,
+	"EUR": 2, "FJD": 2, "FKP": 2, "GBP": 2, "GEL": 2, "GHS": 2, "GIP": 2,
+	"GMD": 2, "GTQ": 2, "GYD": 2, "HKD": 2, "HNL": 2, "HTG": 2, "HUF": 2,
+	"IDR": 2, "ILS": 2, "INR": 2, "IRR": 2, "JMD": 2, "KES": 2, "KGS": 2,
+	"KHR": 2, "KPW": 2, "KYD": 2, "KZT": 2, "LAK": 2, "LBP": 2, "LKR": 2,
+	"LRD": 2, "LSL": 2, "MAD": 2, "MDL": 2, "MGA": 2, "MKD": 2, "MMK": 2,
+	"MNT": 2, "MOP": 2, "MRU": 2, "MUR": 2, "MVR": 2, "MWK": 2, "MXN": 2,
+	"MXV": 2, "MYR": 2, "MZN": 2, "NAD": 2, "NGN": 2, "NIO": 2, "NOK": 2,
+	"NPR": 2, "NZD": 2, "PAB": 2, "PEN": 2, "PGK": 2, "PHP": 2, "PKR": 2,
+	"PLN": 2, "QAR": 2, "RON": 2, "RSD": 2, "RUB": 2, "SAR": 2, "SBD": 2,
+	"SCR": 2, "SDG": 2, "SEK": 2, "SGD": 2, "SHP": 2, "SLE": 2, "SLL": 2,
+	"SOS": 2, "SRD": 2, "SSP": 2, "STN": 2, "SVC": 2, "SYP": 2, "SZL": 2,
+	"THB": 2, "TJS": 2, "TMT": 2, "TOP": 2, "TRY": 2, "TTD": 2, "TWD": 2,
+	"TZS": 2, "UAH": 2, "USD": 2, "USN": 2, "UYU": 2, "UZS": 2, "VED": 2,
+	"VES": 2, "WST": 2, "XCD": 2, "XCG": 2, "YER": 2, "ZAR": 2, "ZMW": 2,
+	"ZWG": 2, "ZWL": 2,
+}
+
+// CurrencyMinorUnits gets the number of digits of the minor unit of an ISO 4217
+// currency code, e.g. 2 for "EUR" and 0 for "JPY".
+//
+// ok is false if the currency isn't known.
+func CurrencyMinorUnits(code string) (digits int, ok bool) {
+	digits, ok = currencyMinorUnits[strings.ToUpper(code)]
+	return digits, ok
+}
+
+// Currency validates that the string is an active ISO 4217 currency code, such
+// as "EUR" or "usd".
+//
+// The code is returned in uppercase.
+func (v *Validator) Currency(key, value string, message ...string) string {
+	if value == "" {
+		return ""
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(value))
+	if _, ok := currencyMinorUnits[code]; !ok {
+		v.Append(key, getMessage(message, MessageCurrency))
+		return ""
+	}
+	return code
+}
+
+// Money is an amount of money in a currency.
+type Money struct {
+	// ISO 4217 currency code, e.g. "EUR".
+	Currency string
+
+	// Amount in the major unit, e.g. 12.50 for €12,50.
+	Amount *big.Rat
+
+	// Amount in the minor unit, e.g. 1250 cents for €12,50.
+	Minor *big.Int
+}
+
+// MoneyOptions are the options for the Money() validator.
+type MoneyOptions struct {
+	// Allowed currencies; the default of nil allows all ISO 4217 currencies.
+	Currencies []string
+
+	// Minimum and maximum amount in the major unit; nil means there is no
+	// limit.
+	Min, Max *big.Rat
+
+	// Reject negative amounts.
+	RejectNegative bool
+}
+
+// Money validates that the currency is a valid ISO 4217 code (see Currency()),
+// and that the amount is a decimal number (see Decimal()) with no more decimal
+// places than the currency's minor unit: "12.50" is valid for EUR, but not for
+// JPY.
+//
+// Errors about the currency are added to currencyKey, and errors about the
+// amount to amountKey.
+//
+// The parsed amount is returned; Amount and Minor are nil if the amount is
+// empty or invalid.
+func (v *Validator) Money(amountKey, currencyKey, amount, currency string, opts MoneyOptions, message ...string) Money {
+	msg := getMessage(message, "")
+	appendMsg := func(key, m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+	}
+
+	code := v.Currency(currencyKey, currency, message...)
+	if code != "" && len(opts.Currencies) > 0 && !includeString(opts.Currencies, code) {
+		appendMsg(currencyKey, fmt.Sprintf(MessageCurrencyAllowed, strings.Join(opts.Currencies, ", ")))
+		code = ""
+	}
+
+	m := Money{Currency: code}
+	if amount == "" {
+		return m
+	}
+
+	r, _, scale, ok := parseDecimal(strings.TrimSpace(amount))
+	if !ok {
+		appendMsg(amountKey, MessageDecimal)
+		return m
+	}
+	m.Amount = r
+	if e := checkRat(r, opts.Min, opts.Max, opts.RejectNegative); e != "" {
+		appendMsg(amountKey, e)
+	}
+	if code == "" {
+		return m
+	}
+
+	digits := currencyMinorUnits[code]
+	if scale > digits {
+		if digits == 0 {
+			appendMsg(amountKey, fmt.Sprintf(MessageMoneyWhole, code))
+		} else {
+			appendMsg(amountKey, fmt.Sprintf(MessageMoneyScale, digits, code))
+		}
+		return m
+	}
+
+	minor := new(big.Rat).Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)))
+	m.Minor = minor.Num()
+	return m
+}
@@ -0,0 +1,117 @@
+package validate
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestCurrency(t *testing.T) {
+	tests := []struct {
+		in, want string
+		wantErr  []string
+	}{
+		{"", "", nil},
+		{"EUR", "EUR", nil},
+		{"usd", "USD", nil},
+		{"XAU", "", []string{MessageCurrency}},
+		{"EURO", "", []string{MessageCurrency}},
+		{"€", "", []string{MessageCurrency}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			got := v.Currency("c", tt.in)
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["c"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["c"], tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCurrencyMinorUnits(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+		ok   bool
+	}{
+		{"EUR", 2, true},
+		{"jpy", 0, true},
+		{"KWD", 3, true},
+		{"CLF", 4, true},
+		{"XXX", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := CurrencyMinorUnits(tt.in)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("\nout:  %d, %t\nwant: %d, %t\n", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestMoney(t *testing.T) {
+	tests := []struct {
+		amount, currency string
+		opts             MoneyOptions
+		wantCurrency     string
+		wantMinor        string
+		wantErr          map[string][]string
+	}{
+		{"", "", MoneyOptions{}, "", "", map[string][]string{}},
+		{"12.50", "EUR", MoneyOptions{}, "EUR", "1250", map[string][]string{}},
+		{"12.5", "eur", MoneyOptions{}, "EUR", "1250", map[string][]string{}},
+		{"-3", "USD", MoneyOptions{}, "USD", "-300", map[string][]string{}},
+		{"1000", "JPY", MoneyOptions{}, "JPY", "1000", map[string][]string{}},
+		{"1.234", "KWD", MoneyOptions{}, "KWD", "1234", map[string][]string{}},
+		{"12.50", "", MoneyOptions{}, "", "", map[string][]string{}},
+		{"12.505", "EUR", MoneyOptions{}, "EUR", "", map[string][]string{
+			"amount": {fmt.Sprintf(MessageMoneyScale, 2, "EUR")},
+		}},
+		{"12.5", "JPY", MoneyOptions{}, "JPY", "", map[string][]string{
+			"amount": {fmt.Sprintf(MessageMoneyWhole, "JPY")},
+		}},
+		{"12.50", "EURO", MoneyOptions{}, "", "", map[string][]string{
+			"currency": {MessageCurrency},
+		}},
+		{"12,50", "EUR", MoneyOptions{}, "EUR", "", map[string][]string{
+			"amount": {MessageDecimal},
+		}},
+		{"12.50", "GBP", MoneyOptions{Currencies: []string{"EUR", "USD"}}, "", "", map[string][]string{
+			"currency": {fmt.Sprintf(MessageCurrencyAllowed, "EUR, USD")},
+		}},
+		{"-1", "EUR", MoneyOptions{RejectNegative: true}, "EUR", "-100", map[string][]string{
+			"amount": {MessageNegative},
+		}},
+		{"1000.01", "EUR", MoneyOptions{Max: big.NewRat(1000, 1)}, "EUR", "100001", map[string][]string{
+			"amount": {fmt.Sprintf(MessageNumberLower, "1000")},
+		}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			got := v.Money("amount", "currency", tt.amount, tt.currency, tt.opts)
+			if got.Currency != tt.wantCurrency {
+				t.Errorf("currency\nout:  %#v\nwant: %#v\n", got.Currency, tt.wantCurrency)
+			}
+			minor := ""
+			if got.Minor != nil {
+				minor = got.Minor.String()
+			}
+			if minor != tt.wantMinor {
+				t.Errorf("minor\nout:  %#v\nwant: %#v\n", minor, tt.wantMinor)
+			}
+			if !reflect.DeepEqual(v.Errors, tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErr)
+			}
+		})
+	}
+}
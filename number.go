@@ -0,0 +1,153 @@
+package validate
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FloatOptions are the options for the Float() validator.
+type FloatOptions struct {
+	// Minimum and maximum value; the default of 0 means there is no limit.
+	Min, Max float64
+
+	// Reject negative values.
+	RejectNegative bool
+}
+
+// Float validates that the string is a floating-point number such as "1.5",
+// "-2", or "1e3", and that it's within the bounds of the options.
+//
+// NaN, infinity, and hexadecimal notation are rejected.
+//
+// The parsed number is returned.
+func (v *Validator) Float(key, value string, opts FloatOptions, message ...string) float64 {
+	if value == "" {
+		return 0
+	}
+
+	value = strings.TrimSpace(value)
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) || strings.ContainsAny(value, "xX_") {
+		v.Append(key, getMessage(message, MessageFloat))
+		return 0
+	}
+
+	switch {
+	case opts.RejectNegative && f < 0:
+		v.Append(key, getMessage(message, MessageNegative))
+	case opts.Min != 0 && f < opts.Min:
+		v.Append(key, getMessage(message, fmt.Sprintf(MessageNumberHigher, formatFloat(opts.Min))))
+	case opts.Max != 0 && f > opts.Max:
+		v.Append(key, getMessage(message, fmt.Sprintf(MessageNumberLower, formatFloat(opts.Max))))
+	}
+	return f
+}
+
+func formatFloat(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+
+// DecimalOptions are the options for the Decimal() validator.
+type DecimalOptions struct {
+	// Maximum number of digits, e.g. 5 for "123.45", not counting leading
+	// zeros before or trailing zeros after the decimal point; the default of 0
+	// means there is no limit.
+	Precision int
+
+	// Maximum number of decimal places, e.g. 2 for "123.45"; the default of 0
+	// means there is no limit. Trailing zeros are not counted, so "1.50" has
+	// one decimal place.
+	Scale int
+
+	// Minimum and maximum value; nil means there is no limit.
+	Min, Max *big.Rat
+
+	// Reject negative values.
+	RejectNegative bool
+}
+
+var reDecimal = regexp.MustCompile(`^[-+]?(\d+)?(?:\.(\d+))?$`)
+
+// parseDecimal parses a decimal number such as "-123.45", returning the number
+// of significant digits before and after the decimal point.
+func parseDecimal(s string) (r *big.Rat, intDigits, scale int, ok bool) {
+	m := reDecimal.FindStringSubmatch(s)
+	if m == nil || (m[1] == "" && m[2] == "") {
+		return nil, 0, 0, false
+	}
+
+	r, ok = new(big.Rat).SetString(s)
+	if !ok {
+		return nil, 0, 0, false
+	}
+	return r, len(strings.TrimLeft(m[1], "0")), len(strings.TrimRight(m[2], "0")), true
+}
+
+// Decimal validates that the string is a decimal number such as "123.45", and
+// that it's within the precision, scale, and bounds of the options.
+//
+// The value is parsed without rounding errors, so it can be used for amounts
+// where floating-point numbers are unsuitable. Exponents ("1e3") are rejected.
+//
+// The parsed number is returned, or nil if the value is empty or invalid.
+func (v *Validator) Decimal(key, value string, opts DecimalOptions, message ...string) *big.Rat {
+	if value == "" {
+		return nil
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+	}
+
+	r, intDigits, scale, ok := parseDecimal(strings.TrimSpace(value))
+	if !ok {
+		appendMsg(MessageDecimal)
+		return nil
+	}
+
+	switch {
+	case opts.Scale > 0 && scale > opts.Scale:
+		appendMsg(fmt.Sprintf(MessageDecimalScale, opts.Scale))
+	case opts.Precision > 0 && intDigits+scale > opts.Precision:
+		appendMsg(fmt.Sprintf(MessageDecimalPrecision, opts.Precision))
+	}
+	if m := checkRat(r, opts.Min, opts.Max, opts.RejectNegative); m != "" {
+		appendMsg(m)
+	}
+	return r
+}
+
+// checkRat checks the bounds of the number, returning the error message if it
+// fails.
+func checkRat(r, min, max *big.Rat, rejectNegative bool) string {
+	switch {
+	case rejectNegative && r.Sign() < 0:
+		return MessageNegative
+	case min != nil && r.Cmp(min) < 0:
+		return fmt.Sprintf(MessageNumberHigher, formatRat(min))
+	case max != nil && r.Cmp(max) > 0:
+		return fmt.Sprintf(MessageNumberLower, formatRat(max))
+	}
+	return ""
+}
+
+// formatRat formats the number as a decimal, e.g. "0.25", with at most 20
+// decimal places.
+func formatRat(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	for n := 1; n < 20; n++ {
+		s := r.FloatString(n)
+		if p, ok := new(big.Rat).SetString(s); ok && p.Cmp(r) == 0 {
+			return s
+		}
+	}
+	return r.FloatString(20)
+}
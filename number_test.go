@@ -0,0 +1,97 @@
+package validate
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestFloat(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    FloatOptions
+		want    float64
+		wantErr []string
+	}{
+		{"", FloatOptions{}, 0, nil},
+		{"1.5", FloatOptions{}, 1.5, nil},
+		{" -2 ", FloatOptions{}, -2, nil},
+		{"1e3", FloatOptions{}, 1000, nil},
+		{".5", FloatOptions{}, 0.5, nil},
+		{"NaN", FloatOptions{}, 0, []string{MessageFloat}},
+		{"inf", FloatOptions{}, 0, []string{MessageFloat}},
+		{"-Infinity", FloatOptions{}, 0, []string{MessageFloat}},
+		{"1e400", FloatOptions{}, 0, []string{MessageFloat}},
+		{"0x1p-2", FloatOptions{}, 0, []string{MessageFloat}},
+		{"1,5", FloatOptions{}, 0, []string{MessageFloat}},
+		{"abc", FloatOptions{}, 0, []string{MessageFloat}},
+		{"-0.1", FloatOptions{RejectNegative: true}, -0.1, []string{MessageNegative}},
+		{"0.5", FloatOptions{Min: 1}, 0.5, []string{fmt.Sprintf(MessageNumberHigher, "1")}},
+		{"99.95", FloatOptions{Max: 99.9}, 99.95, []string{fmt.Sprintf(MessageNumberLower, "99.9")}},
+		{"-5", FloatOptions{Min: -10, Max: 10}, -5, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			got := v.Float("f", tt.in, tt.opts)
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["f"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["f"], tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	rat := func(s string) *big.Rat {
+		r, _ := new(big.Rat).SetString(s)
+		return r
+	}
+
+	tests := []struct {
+		in      string
+		opts    DecimalOptions
+		want    string
+		wantErr []string
+	}{
+		{"", DecimalOptions{}, "", nil},
+		{"123.45", DecimalOptions{}, "123.45", nil},
+		{"-0.1", DecimalOptions{}, "-0.1", nil},
+		{"+.5", DecimalOptions{}, "0.5", nil},
+		{"0.1000000000000000000000000001", DecimalOptions{}, "0.1000000000000000000000000001", nil},
+		{"1e3", DecimalOptions{}, "", []string{MessageDecimal}},
+		{"1.", DecimalOptions{}, "", []string{MessageDecimal}},
+		{".", DecimalOptions{}, "", []string{MessageDecimal}},
+		{"-", DecimalOptions{}, "", []string{MessageDecimal}},
+		{"1,5", DecimalOptions{}, "", []string{MessageDecimal}},
+		{"NaN", DecimalOptions{}, "", []string{MessageDecimal}},
+
+		{"12.34", DecimalOptions{Scale: 2}, "12.34", nil},
+		{"12.340", DecimalOptions{Scale: 2}, "12.34", nil},
+		{"12.345", DecimalOptions{Scale: 2}, "12.345", []string{fmt.Sprintf(MessageDecimalScale, 2)}},
+		{"123.45", DecimalOptions{Precision: 5}, "123.45", nil},
+		{"00123.450", DecimalOptions{Precision: 5}, "123.45", nil},
+		{"1234.5", DecimalOptions{Precision: 4}, "1234.5", []string{fmt.Sprintf(MessageDecimalPrecision, 4)}},
+		{"-1", DecimalOptions{RejectNegative: true}, "-1", []string{MessageNegative}},
+		{"0.001", DecimalOptions{Min: rat("0.01")}, "0.001", []string{fmt.Sprintf(MessageNumberHigher, "0.01")}},
+		{"100.01", DecimalOptions{Max: rat("100")}, "100.01", []string{fmt.Sprintf(MessageNumberLower, "100")}},
+		{"0.34", DecimalOptions{Max: rat("1/3")}, "0.34", []string{fmt.Sprintf(MessageNumberLower, "0.33333333333333333333")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			got := v.Decimal("d", tt.in, tt.opts)
+			if (got == nil) != (tt.want == "") || (got != nil && got.Cmp(rat(tt.want)) != 0) {
+				t.Errorf("\nout:  %v\nwant: %v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["d"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["d"], tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package validate
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PathElement is a single element of a Path: either a field name or an index.
+//
+// Indexes are strings since Sub() accepts arbitrary subkeys (e.g. "home" or
+// "1").
+type PathElement struct {
+	Name    string
+	IsIndex bool
+}
+
+// PathField creates a field name path element.
+func PathField(name string) PathElement { return PathElement{Name: name} }
+
+// PathIndex creates an index path element.
+func PathIndex(index string) PathElement { return PathElement{Name: index, IsIndex: true} }
+
+// Path is the structured location of an error, as a sequence of field names
+// and indexes.
+//
+// The Errors map is keyed by the dotted representation of the Path (e.g.
+// "addresses[1].city"), which is ambiguous if a field name contains a dot or
+// bracket. The Path for a key is stored along with the error whenever it's
+// known, and can be retrieved with Validator.Path().
+type Path []PathElement
+
+// Append returns a new path with the elements added.
+func (p Path) Append(e ...PathElement) Path {
+	n := make(Path, 0, len(p)+len(e))
+	n = append(n, p...)
+	return append(n, e...)
+}
+
+// HasPrefix reports if the path starts with all the elements of prefix.
+func (p Path) HasPrefix(prefix Path) bool {
+	if len(prefix) > len(p) {
+		return false
+	}
+	for i := range prefix {
+		if p[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats the path in the dotted style used for the Errors keys, e.g.
+// "addresses[1].city".
+func (p Path) String() string {
+	var b strings.Builder
+	for i, e := range p {
+		switch {
+		case e.IsIndex:
+			b.WriteString("[" + e.Name + "]")
+		case i > 0:
+			b.WriteString("." + e.Name)
+		default:
+			b.WriteString(e.Name)
+		}
+	}
+	return b.String()
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// JSONPointer formats the path as a JSON Pointer (RFC 6901), e.g.
+// "/addresses/1/city".
+func (p Path) JSONPointer() string {
+	var b strings.Builder
+	for _, e := range p {
+		b.WriteString("/" + jsonPointerEscaper.Replace(e.Name))
+	}
+	return b.String()
+}
+
+var reJSONPathIdent = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// JSONPath formats the path as a JSONPath expression, e.g.
+// "$.addresses[1].city".
+//
+// Field names which aren't simple identifiers and non-numeric indexes are
+// written in bracket notation: "$['first name']['home']".
+func (p Path) JSONPath() string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, e := range p {
+		switch {
+		case e.IsIndex && isIndexNumber(e.Name):
+			b.WriteString("[" + e.Name + "]")
+		case !e.IsIndex && reJSONPathIdent.MatchString(e.Name):
+			b.WriteString("." + e.Name)
+		default:
+			n := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(e.Name)
+			b.WriteString("['" + n + "']")
+		}
+	}
+	return b.String()
+}
+
+func isIndexNumber(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
+
+// ParsePath parses a key in the dotted style (e.g. "addresses[1].city") back
+// in to a Path.
+//
+// Since the dotted style is ambiguous this won't always return the Path that
+// was used to create the key; prefer Validator.Path() where possible.
+func ParsePath(key string) (Path, error) {
+	if key == "" {
+		return Path{}, nil
+	}
+
+	var (
+		p     Path
+		field strings.Builder
+		// Set after "]" to indicate a field can't directly follow.
+		afterIndex bool
+	)
+	for i := 0; i < len(key); i++ {
+		switch c := key[i]; c {
+		case '.':
+			if !afterIndex {
+				if field.Len() == 0 {
+					return nil, errors.New("validate: empty field name in path " + strconv.Quote(key))
+				}
+				p = append(p, PathField(field.String()))
+				field.Reset()
+			}
+			afterIndex = false
+			if i == len(key)-1 {
+				return nil, errors.New("validate: path ends with a dot " + strconv.Quote(key))
+			}
+		case '[':
+			if field.Len() > 0 {
+				p = append(p, PathField(field.String()))
+				field.Reset()
+			}
+			end := strings.IndexByte(key[i:], ']')
+			if end == -1 {
+				return nil, errors.New("validate: unterminated index in path " + strconv.Quote(key))
+			}
+			p = append(p, PathIndex(key[i+1:i+end]))
+			i += end
+			afterIndex = true
+		case ']':
+			return nil, errors.New("validate: unexpected ']' in path " + strconv.Quote(key))
+		default:
+			if afterIndex {
+				return nil, errors.New("validate: missing '.' after index in path " + strconv.Quote(key))
+			}
+			field.WriteByte(c)
+		}
+	}
+	if field.Len() > 0 {
+		p = append(p, PathField(field.String()))
+	}
+	return p, nil
+}
@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPathFormat(t *testing.T) {
+	tests := []struct {
+		in                               Path
+		dotted, jsonPointer, jsonPathStr string
+	}{
+		{Path{}, "", "", "$"},
+		{Path{PathField("name")}, "name", "/name", "$.name"},
+		{
+			Path{PathField("addresses"), PathIndex("1"), PathField("city")},
+			"addresses[1].city", "/addresses/1/city", "$.addresses[1].city",
+		},
+		{
+			Path{PathField("addresses"), PathIndex("home"), PathField("city")},
+			"addresses[home].city", "/addresses/home/city", "$.addresses['home'].city",
+		},
+		{
+			Path{PathField("a.b"), PathField("c/d~e"), PathField("it's")},
+			"a.b.c/d~e.it's", "/a.b/c~1d~0e/it's", `$['a.b']['c/d~e']['it\'s']`,
+		},
+		{
+			Path{PathIndex("0"), PathIndex("1")},
+			"[0][1]", "/0/1", "$[0][1]",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			if out := tt.in.String(); out != tt.dotted {
+				t.Errorf("String\nout:  %#v\nwant: %#v\n", out, tt.dotted)
+			}
+			if out := tt.in.JSONPointer(); out != tt.jsonPointer {
+				t.Errorf("JSONPointer\nout:  %#v\nwant: %#v\n", out, tt.jsonPointer)
+			}
+			if out := tt.in.JSONPath(); out != tt.jsonPathStr {
+				t.Errorf("JSONPath\nout:  %#v\nwant: %#v\n", out, tt.jsonPathStr)
+			}
+		})
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Path
+		wantErr bool
+	}{
+		{"", Path{}, false},
+		{"name", Path{PathField("name")}, false},
+		{"a.b.c", Path{PathField("a"), PathField("b"), PathField("c")}, false},
+		{"a[1]", Path{PathField("a"), PathIndex("1")}, false},
+		{"a[home].city", Path{PathField("a"), PathIndex("home"), PathField("city")}, false},
+		{"a[1][2].b", Path{PathField("a"), PathIndex("1"), PathIndex("2"), PathField("b")}, false},
+		{"[0].a", Path{PathIndex("0"), PathField("a")}, false},
+		{"a[]", Path{PathField("a"), PathIndex("")}, false},
+
+		{"a..b", nil, true},
+		{".a", nil, true},
+		{"a.", nil, true},
+		{"a[1", nil, true},
+		{"a]", nil, true},
+		{"a[1]b", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			out, err := ParsePath(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("wrong error: %v", err)
+			}
+			if !reflect.DeepEqual(out, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+			if !tt.wantErr && out.String() != tt.in {
+				t.Errorf("round trip\nout:  %#v\nwant: %#v\n", out.String(), tt.in)
+			}
+		})
+	}
+}
+
+func TestValidatorPath(t *testing.T) {
+	s := New()
+	s.Append("zip.code", "must be set")
+	s.AppendPath(Path{PathField("a[b]")}, "weird")
+
+	v := New()
+	v.Append("addresses[0].city", "must be set")
+	v.Append("broken[", "oh noes")
+	v.Sub("first.name", "", errors.New("must be set"))
+	v.Sub("addresses", "home.1", s)
+
+	tests := []struct {
+		key  string
+		want Path
+	}{
+		{"addresses[0].city", Path{PathField("addresses"), PathIndex("0"), PathField("city")}},
+		{"broken[", Path{PathField("broken[")}},
+		{"first.name", Path{PathField("first.name")}},
+		{"addresses[home.1].zip.code", Path{
+			PathField("addresses"), PathIndex("home.1"), PathField("zip"), PathField("code")}},
+		{"addresses[home.1].a[b]", Path{
+			PathField("addresses"), PathIndex("home.1"), PathField("a[b]")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if _, ok := v.Errors[tt.key]; !ok {
+				t.Fatalf("key not in Errors: %v", v.Errors)
+			}
+			if out := v.Path(tt.key); !reflect.DeepEqual(out, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+		})
+	}
+
+	t.Run("merge", func(t *testing.T) {
+		m := New()
+		m.Merge(v)
+		want := Path{PathField("first.name")}
+		if out := m.Path("first.name"); !reflect.DeepEqual(out, want) {
+			t.Errorf("\nout:  %#v\nwant: %#v\n", out, want)
+		}
+	})
+}
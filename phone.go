@@ -0,0 +1,444 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PhoneType is a set of phone number types, for use in PhoneOptions.
+type PhoneType uint
+
+// Phone number types.
+const (
+	PhoneMobile PhoneType = 1 << iota
+	PhoneFixed
+	PhoneTollFree
+)
+
+var phoneTypeNames = []struct {
+	t    PhoneType
+	name string
+}{
+	{PhoneMobile, "mobile"},
+	{PhoneFixed, "landline"},
+	{PhoneTollFree, "toll-free"},
+}
+
+// String gets a description of the types, e.g. "mobile or landline".
+func (t PhoneType) String() string {
+	var names []string
+	for _, n := range phoneTypeNames {
+		if t&n.t != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return strings.Join(names, " or ")
+}
+
+// phoneRegion is the metadata for a country. The patterns match the national
+// significant number, which is the number without the trunk prefix.
+type phoneRegion struct {
+	country string // ISO 3166-1 alpha-2 code.
+	code    string // Country calling code.
+	trunk   string // National (trunk) prefix, e.g. "0".
+	intl    string // International call prefix, e.g. "00".
+
+	mobile, fixed, tollFree *regexp.Regexp
+
+	// Mobile and fixed numbers have the same format (e.g. in the North
+	// American Numbering Plan).
+	fixedOrMobile bool
+}
+
+func phonePattern(p string) *regexp.Regexp { return regexp.MustCompile(`^(?:` + p + `)$`) }
+
+// Phone number metadata for a subset of countries, based on the ITU-T
+// national numbering plans. Numbers with other calling codes in
+// phoneCallingCodes are validated with the generic E.164 rules only.
+var phoneRegions = []phoneRegion{
+	{
+		// Before the US, as the patterns for the US match all area codes.
+		// Toll-free numbers are shared by all NANP countries, and are
+		// reported as the US.
+		country: "CA", code: "1", trunk: "1", intl: "011", fixedOrMobile: true,
+		fixed: phonePattern(`(?:2(?:04|26|36|49|50|63|89)|3(?:06|43|54|65|67|68|82)|` +
+			`4(?:03|16|18|28|31|37|38|50|68|74)|5(?:06|14|19|48|79|81|84|87)|` +
+			`6(?:00|04|13|39|47|72|83)|7(?:05|09|42|53|78|80|82)|` +
+			`8(?:07|19|25|67|73|79)|90[25])[2-9]\d{6}`),
+	},
+	{
+		country: "US", code: "1", trunk: "1", intl: "011", fixedOrMobile: true,
+		fixed:    phonePattern(`[2-9]\d{2}[2-9]\d{6}`),
+		tollFree: phonePattern(`8(?:00|33|44|55|66|77|88)[2-9]\d{6}`),
+	},
+	{
+		country: "GB", code: "44", trunk: "0", intl: "00",
+		mobile:   phonePattern(`7[1-57-9]\d{8}`),
+		fixed:    phonePattern(`[12]\d{8,9}|3\d{9}`),
+		tollFree: phonePattern(`80[08]\d{6,7}`),
+	},
+	{
+		country: "IE", code: "353", trunk: "0", intl: "00",
+		mobile:   phonePattern(`8[35-9]\d{7}`),
+		fixed:    phonePattern(`1\d{7,8}|[2-9]\d{6,8}`),
+		tollFree: phonePattern(`1800\d{6}`),
+	},
+	{
+		country: "NL", code: "31", trunk: "0", intl: "00",
+		mobile:   phonePattern(`6[1-58]\d{7}`),
+		fixed:    phonePattern(`(?:[1-57]\d|8[58])\d{7}`),
+		tollFree: phonePattern(`800\d{4,7}`),
+	},
+	{
+		country: "BE", code: "32", trunk: "0", intl: "00",
+		mobile:   phonePattern(`4[5-9]\d{7}`),
+		fixed:    phonePattern(`[1-9]\d{7}`),
+		tollFree: phonePattern(`800\d{5}`),
+	},
+	{
+		country: "DE", code: "49", trunk: "0", intl: "00",
+		mobile:   phonePattern(`15\d{9}|1[67]\d{8,9}`),
+		fixed:    phonePattern(`[2-9]\d{5,10}`),
+		tollFree: phonePattern(`800\d{7,9}`),
+	},
+	{
+		country: "FR", code: "33", trunk: "0", intl: "00",
+		mobile:   phonePattern(`[67]\d{8}`),
+		fixed:    phonePattern(`[1-59]\d{8}`),
+		tollFree: phonePattern(`80[0-5]\d{6}`),
+	},
+	{
+		country: "ES", code: "34", intl: "00",
+		mobile:   phonePattern(`(?:6\d|7[1-9])\d{7}`),
+		fixed:    phonePattern(`[89][1-9]\d{7}`),
+		tollFree: phonePattern(`[89]00\d{6}`),
+	},
+	{
+		// The leading 0 of landlines is part of the number in Italy.
+		country: "IT", code: "39", intl: "00",
+		mobile:   phonePattern(`3\d{8,9}`),
+		fixed:    phonePattern(`0\d{5,10}`),
+		tollFree: phonePattern(`80(?:0\d{3}|3)\d{3}`),
+	},
+	{
+		country: "AU", code: "61", trunk: "0", intl: "0011",
+		mobile:   phonePattern(`4\d{8}`),
+		fixed:    phonePattern(`[2378]\d{8}`),
+		tollFree: phonePattern(`180(?:0\d{3}|2)\d{3}`),
+	},
+	{
+		country: "NZ", code: "64", trunk: "0", intl: "00",
+		mobile:   phonePattern(`2[0-28]\d{6,8}`),
+		fixed:    phonePattern(`[3-79]\d{7}`),
+		tollFree: phonePattern(`80[08]\d{6,7}`),
+	},
+	{
+		country: "IN", code: "91", trunk: "0", intl: "00",
+		mobile:   phonePattern(`[6-9]\d{9}`),
+		fixed:    phonePattern(`[1-5]\d{9}`),
+		tollFree: phonePattern(`1800\d{6,7}`),
+	},
+	{
+		country: "JP", code: "81", trunk: "0", intl: "010",
+		mobile:   phonePattern(`[7-9]0\d{8}`),
+		fixed:    phonePattern(`[1-9]\d{8}`),
+		tollFree: phonePattern(`120\d{6}|800\d{7}`),
+	},
+}
+
+// Country calling codes assigned by the ITU-T (E.164 annex), with the ISO
+// 3166-1 alpha-2 codes of the regions that use them; the main region is
+// listed first. Non-geographic codes such as +800 have no regions.
+var phoneCallingCodes = map[string][]string{
+	"1": {"US", "AG", "AI", "AS", "BB", "BM", "BS", "CA", "DM", "DO", "GD", "GU",
+		"JM", "KN", "KY", "LC", "MP", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI"},
+	"7":  {"RU", "KZ"},
+	"20": {"EG"}, "27": {"ZA"},
+	"30": {"GR"}, "31": {"NL"}, "32": {"BE"}, "33": {"FR"}, "34": {"ES"},
+	"36": {"HU"}, "39": {"IT", "VA"},
+	"40": {"RO"}, "41": {"CH"}, "43": {"AT"}, "44": {"GB", "GG", "IM", "JE"},
+	"45": {"DK"}, "46": {"SE"}, "47": {"NO", "SJ"}, "48": {"PL"}, "49": {"DE"},
+	"51": {"PE"}, "52": {"MX"}, "53": {"CU"}, "54": {"AR"}, "55": {"BR"},
+	"56": {"CL"}, "57": {"CO"}, "58": {"VE"},
+	"60": {"MY"}, "61": {"AU", "CC", "CX"}, "62": {"ID"}, "63": {"PH"},
+	"64": {"NZ"}, "65": {"SG"}, "66": {"TH"},
+	"81": {"JP"}, "82": {"KR"}, "84": {"VN"}, "86": {"CN"},
+	"90": {"TR"}, "91": {"IN"}, "92": {"PK"}, "93": {"AF"}, "94": {"LK"},
+	"95": {"MM"}, "98": {"IR"},
+
+	"211": {"SS"}, "212": {"MA", "EH"}, "213": {"DZ"}, "216": {"TN"}, "218": {"LY"},
+	"220": {"GM"}, "221": {"SN"}, "222": {"MR"}, "223": {"ML"}, "224": {"GN"},
+	"225": {"CI"}, "226": {"BF"}, "227": {"NE"}, "228": {"TG"}, "229": {"BJ"},
+	"230": {"MU"}, "231": {"LR"}, "232": {"SL"}, "233": {"GH"}, "234": {"NG"},
+	"235": {"TD"}, "236": {"CF"}, "237": {"CM"}, "238": {"CV"}, "239": {"ST"},
+	"240": {"GQ"}, "241": {"GA"}, "242": {"CG"}, "243": {"CD"}, "244": {"AO"},
+	"245": {"GW"}, "246": {"IO"}, "247": {"AC"}, "248": {"SC"}, "249": {"SD"},
+	"250": {"RW"}, "251": {"ET"}, "252": {"SO"}, "253": {"DJ"}, "254": {"KE"},
+	"255": {"TZ"}, "256": {"UG"}, "257": {"BI"}, "258": {"MZ"},
+	"260": {"ZM"}, "261": {"MG"}, "262": {"RE", "YT"}, "263": {"ZW"}, "264": {"NA"},
+	"265": {"MW"}, "266": {"LS"}, "267": {"BW"}, "268": {"SZ"}, "269": {"KM"},
+	"290": {"SH", "TA"}, "291": {"ER"}, "297": {"AW"}, "298": {"FO"}, "299": {"GL"},
+
+	"350": {"GI"}, "351": {"PT"}, "352": {"LU"}, "353": {"IE"}, "354": {"IS"},
+	"355": {"AL"}, "356": {"MT"}, "357": {"CY"}, "358": {"FI", "AX"}, "359": {"BG"},
+	"370": {"LT"}, "371": {"LV"}, "372": {"EE"}, "373": {"MD"}, "374": {"AM"},
+	"375": {"BY"}, "376": {"AD"}, "377": {"MC"}, "378": {"SM"},
+	"380": {"UA"}, "381": {"RS"}, "382": {"ME"}, "383": {"XK"}, "385": {"HR"},
+	"386": {"SI"}, "387": {"BA"}, "389": {"MK"},
+	"420": {"CZ"}, "421": {"SK"}, "423": {"LI"},
+
+	"500": {"FK"}, "501": {"BZ"}, "502": {"GT"}, "503": {"SV"}, "504": {"HN"},
+	"505": {"NI"}, "506": {"CR"}, "507": {"PA"}, "508": {"PM"}, "509": {"HT"},
+	"590": {"GP", "BL", "MF"}, "591": {"BO"}, "592": {"GY"}, "593": {"EC"},
+	"594": {"GF"}, "595": {"PY"}, "596": {"MQ"}, "597": {"SR"}, "598": {"UY"},
+	"599": {"CW", "BQ"},
+
+	"670": {"TL"}, "672": {"NF"}, "673": {"BN"}, "674": {"NR"}, "675": {"PG"},
+	"676": {"TO"}, "677": {"SB"}, "678": {"VU"}, "679": {"FJ"},
+	"680": {"PW"}, "681": {"WF"}, "682": {"CK"}, "683": {"NU"}, "685": {"WS"},
+	"686": {"KI"}, "687": {"NC"}, "688": {"TV"}, "689": {"PF"},
+	"690": {"TK"}, "691": {"FM"}, "692": {"MH"},
+
+	"800": nil, "808": nil, "870": nil, "878": nil, "881": nil, "882": nil,
+	"883": nil, "888": nil, "979": nil,
+	"850": {"KP"}, "852": {"HK"}, "853": {"MO"}, "855": {"KH"}, "856": {"LA"},
+	"880": {"BD"}, "886": {"TW"},
+
+	"960": {"MV"}, "961": {"LB"}, "962": {"JO"}, "963": {"SY"}, "964": {"IQ"},
+	"965": {"KW"}, "966": {"SA"}, "967": {"YE"}, "968": {"OM"},
+	"970": {"PS"}, "971": {"AE"}, "972": {"IL"}, "973": {"BH"}, "974": {"QA"},
+	"975": {"BT"}, "976": {"MN"}, "977": {"NP"},
+	"992": {"TJ"}, "993": {"TM"}, "994": {"AZ"}, "995": {"GE"}, "996": {"KG"},
+	"998": {"UZ"},
+}
+
+// Leading digits of the national significant number for regions that share a
+// calling code with the main region, for calling codes without metadata in
+// phoneRegions.
+var phoneSharedRegions = map[string][]struct{ prefix, country string }{
+	"7":   {{"6", "KZ"}, {"7", "KZ"}},
+	"47":  {{"79", "SJ"}},
+	"358": {{"18", "AX"}},
+}
+
+// phoneCountry gets the region for a number with a calling code that has no
+// metadata in phoneRegions.
+func phoneCountry(code, nsn string) string {
+	for _, s := range phoneSharedRegions[code] {
+		if strings.HasPrefix(nsn, s.prefix) {
+			return s.country
+		}
+	}
+	if r := phoneCallingCodes[code]; len(r) > 0 {
+		return r[0]
+	}
+	return ""
+}
+
+// knownPhoneRegion reports if the region is in phoneCallingCodes.
+func knownPhoneRegion(country string) bool {
+	for _, regions := range phoneCallingCodes {
+		if includeString(regions, country) {
+			return true
+		}
+	}
+	return false
+}
+
+func phoneRegionFor(country string) *phoneRegion {
+	for i := range phoneRegions {
+		if strings.EqualFold(phoneRegions[i].country, country) {
+			return &phoneRegions[i]
+		}
+	}
+	return nil
+}
+
+// numberType gets the type of the national significant number, or 0 if it's
+// not valid for this region.
+func (r *phoneRegion) numberType(nsn string) PhoneType {
+	switch {
+	case r.tollFree != nil && r.tollFree.MatchString(nsn):
+		return PhoneTollFree
+	case r.mobile != nil && r.mobile.MatchString(nsn):
+		return PhoneMobile
+	case r.fixed != nil && r.fixed.MatchString(nsn) && r.fixedOrMobile:
+		return PhoneFixed | PhoneMobile
+	case r.fixed != nil && r.fixed.MatchString(nsn):
+		return PhoneFixed
+	}
+	return 0
+}
+
+// PhoneNumber is a parsed phone number.
+type PhoneNumber struct {
+	// Number in E.164 format, e.g. "+31612345678".
+	E164 string
+
+	// ISO 3166-1 alpha-2 country code, e.g. "NL", or "" for non-geographic
+	// calling codes such as +800.
+	Country string
+
+	// Country calling code, e.g. "31".
+	CallingCode string
+
+	// National significant number, e.g. "612345678".
+	National string
+
+	// Type of the number; for countries where mobile numbers can't be
+	// distinguished (such as the US) this is PhoneFixed|PhoneMobile. This is 0
+	// for countries without metadata.
+	Type PhoneType
+}
+
+// PhoneOptions are the options for the PhoneWithOptions() validator.
+type PhoneOptions struct {
+	// ISO 3166-1 alpha-2 code of the country for numbers written without a
+	// country calling code, such as "06 12345678" for "NL". Numbers must start
+	// with "+" or an international call prefix if this is not set.
+	//
+	// This must be one of the countries with metadata listed in
+	// PhoneWithOptions(), as the trunk and international call prefixes are
+	// needed to parse national numbers.
+	DefaultRegion string
+
+	// Only allow numbers from these countries (ISO 3166-1 alpha-2 codes); the
+	// default of nil allows all countries. Any country with a calling code
+	// can be used.
+	Countries []string
+
+	// Only allow these types of numbers; the default of 0 allows all types.
+	Types PhoneType
+}
+
+// Formatting characters that are removed when parsing a phone number.
+var phoneFormatting = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "", "\u00a0", "")
+
+// PhoneWithOptions validates that the value is a valid phone number, such as
+// "+31 6 1234 5678", or "06-12345678" with the default region "NL".
+//
+// The calling code must be assigned by the ITU. The number is validated with
+// the number lengths and ranges of the country, for the countries for which
+// this package has metadata (US, CA, GB, IE, NL, BE, DE, FR, ES, IT, AU, NZ,
+// IN, JP). Numbers from other countries are only validated to be valid E.164
+// numbers, and are rejected if Types is set. For calling codes shared by
+// several countries without metadata, such as +7 for Russia and Kazakhstan,
+// the country is derived from the leading digits where possible, and is
+// otherwise the main country for the calling code.
+//
+// This panics if DefaultRegion has no metadata or if Countries contains an
+// unknown country, as these are programming errors.
+//
+// The parsed number is returned.
+func (v *Validator) PhoneWithOptions(key, value string, opts PhoneOptions, message ...string) PhoneNumber {
+	if opts.DefaultRegion != "" && phoneRegionFor(opts.DefaultRegion) == nil {
+		panic(fmt.Sprintf("validate: no phone number metadata for DefaultRegion %q", opts.DefaultRegion))
+	}
+	for _, c := range opts.Countries {
+		if !knownPhoneRegion(c) {
+			panic(fmt.Sprintf("validate: unknown country %q in PhoneOptions.Countries", c))
+		}
+	}
+
+	if value == "" {
+		return PhoneNumber{}
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) PhoneNumber {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+		return PhoneNumber{}
+	}
+
+	num, m := parsePhone(value, opts.DefaultRegion)
+	if m != "" {
+		return appendMsg(m)
+	}
+
+	if len(opts.Countries) > 0 && !includeString(opts.Countries, num.Country) {
+		return appendMsg(fmt.Sprintf(MessagePhoneCountry, strings.Join(opts.Countries, ", ")))
+	}
+	if opts.Types != 0 && num.Type&opts.Types == 0 {
+		return appendMsg(fmt.Sprintf(MessagePhoneType, opts.Types))
+	}
+	return num
+}
+
+// parsePhone parses the phone number, returning the error message if it's
+// invalid.
+func parsePhone(value, defaultRegion string) (PhoneNumber, string) {
+	value = strings.TrimSpace(value)
+	intl := strings.HasPrefix(value, "+")
+	if intl {
+		// "+31 (0)6 12345678" is a common way to write the trunk prefix.
+		value = strings.Replace(value[1:], "(0)", "", 1)
+	}
+
+	digits := phoneFormatting.Replace(value)
+	if digits == "" {
+		return PhoneNumber{}, MessagePhone
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return PhoneNumber{}, MessagePhone
+		}
+	}
+
+	def := phoneRegionFor(defaultRegion)
+	if !intl && def != nil && strings.HasPrefix(digits, def.intl) {
+		intl, digits = true, digits[len(def.intl):]
+	}
+
+	if !intl {
+		if def == nil {
+			return PhoneNumber{}, MessagePhoneCountryCode
+		}
+		nsn := digits
+		if def.trunk != "" {
+			nsn = strings.TrimPrefix(digits, def.trunk)
+		}
+		return phoneNumber(def.code, nsn)
+	}
+
+	// Calling codes are prefix-free, so at most one of these matches.
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		code := digits[:n]
+		if _, ok := phoneCallingCodes[code]; !ok {
+			continue
+		}
+		for _, r := range phoneRegions {
+			if r.code == code {
+				return phoneNumber(code, digits[n:])
+			}
+		}
+
+		// No metadata; E.164 numbers are at most 15 digits.
+		if len(digits) < 7 || len(digits) > 15 {
+			return PhoneNumber{}, MessagePhone
+		}
+		nsn := digits[n:]
+		return PhoneNumber{E164: "+" + digits, Country: phoneCountry(code, nsn), CallingCode: code, National: nsn}, ""
+	}
+
+	// Not an assigned calling code.
+	return PhoneNumber{}, MessagePhone
+}
+
+// phoneNumber validates the national significant number with the regions for
+// the calling code.
+func phoneNumber(code, nsn string) (PhoneNumber, string) {
+	for i := range phoneRegions {
+		r := &phoneRegions[i]
+		if r.code != code {
+			continue
+		}
+		if t := r.numberType(nsn); t != 0 {
+			return PhoneNumber{E164: "+" + code + nsn, Country: r.country, CallingCode: code, National: nsn, Type: t}, ""
+		}
+	}
+	return PhoneNumber{}, MessagePhone
+}
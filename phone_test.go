@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPhoneType(t *testing.T) {
+	tests := []struct {
+		in   PhoneType
+		want string
+	}{
+		{0, ""},
+		{PhoneMobile, "mobile"},
+		{PhoneFixed | PhoneMobile, "mobile or landline"},
+		{PhoneTollFree, "toll-free"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.in.String()
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPhoneWithOptions(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    PhoneOptions
+		want    PhoneNumber
+		wantErr string
+	}{
+		{"", PhoneOptions{}, PhoneNumber{}, ""},
+		{"-----", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+31 6 1234 567x", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+
+		// International format.
+		{"+31 6 12345678", PhoneOptions{},
+			PhoneNumber{"+31612345678", "NL", "31", "612345678", PhoneMobile}, ""},
+		{"+31 (0)20-123 4567", PhoneOptions{},
+			PhoneNumber{"+31201234567", "NL", "31", "201234567", PhoneFixed}, ""},
+		{"+31 6 1234567", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+44 7911 123456", PhoneOptions{},
+			PhoneNumber{"+447911123456", "GB", "44", "7911123456", PhoneMobile}, ""},
+		{"+44 800 123 4567", PhoneOptions{},
+			PhoneNumber{"+448001234567", "GB", "44", "8001234567", PhoneTollFree}, ""},
+		{"+1 (212) 555-1234", PhoneOptions{},
+			PhoneNumber{"+12125551234", "US", "1", "2125551234", PhoneFixed | PhoneMobile}, ""},
+		{"+1 416 555 1234", PhoneOptions{},
+			PhoneNumber{"+14165551234", "CA", "1", "4165551234", PhoneFixed | PhoneMobile}, ""},
+		{"+1 112 555 1234", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+39 06 1234 5678", PhoneOptions{},
+			PhoneNumber{"+390612345678", "IT", "39", "0612345678", PhoneFixed}, ""},
+		{"+353 85 123 4567", PhoneOptions{},
+			PhoneNumber{"+353851234567", "IE", "353", "851234567", PhoneMobile}, ""},
+
+		// Calling code without metadata.
+		{"+421 912 123 456", PhoneOptions{},
+			PhoneNumber{"+421912123456", "SK", "421", "912123456", 0}, ""},
+		{"+421 12", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+421 1234 5678 9012 34", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+7 495 123 45 67", PhoneOptions{},
+			PhoneNumber{"+74951234567", "RU", "7", "4951234567", 0}, ""},
+		{"+7 701 123 45 67", PhoneOptions{},
+			PhoneNumber{"+77011234567", "KZ", "7", "7011234567", 0}, ""},
+		{"+358 18 123 456", PhoneOptions{},
+			PhoneNumber{"+35818123456", "AX", "358", "18123456", 0}, ""},
+		{"+800 1234 5678", PhoneOptions{},
+			PhoneNumber{"+80012345678", "", "800", "12345678", 0}, ""},
+
+		// Unassigned calling code.
+		{"+999 123 456 789", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+259 123 456 789", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+		{"+0 123 456 789", PhoneOptions{}, PhoneNumber{}, MessagePhone},
+
+		// National format.
+		{"06 12345678", PhoneOptions{}, PhoneNumber{}, MessagePhoneCountryCode},
+		{"06 12345678", PhoneOptions{DefaultRegion: "NL"},
+			PhoneNumber{"+31612345678", "NL", "31", "612345678", PhoneMobile}, ""},
+		{"0031 6 12345678", PhoneOptions{DefaultRegion: "NL"},
+			PhoneNumber{"+31612345678", "NL", "31", "612345678", PhoneMobile}, ""},
+		{"(212) 555-1234", PhoneOptions{DefaultRegion: "us"},
+			PhoneNumber{"+12125551234", "US", "1", "2125551234", PhoneFixed | PhoneMobile}, ""},
+		{"(416) 555-1234", PhoneOptions{DefaultRegion: "US"},
+			PhoneNumber{"+14165551234", "CA", "1", "4165551234", PhoneFixed | PhoneMobile}, ""},
+		{"1-800-555-1234", PhoneOptions{DefaultRegion: "CA"},
+			PhoneNumber{"+18005551234", "US", "1", "8005551234", PhoneTollFree}, ""},
+		{"011 44 7911 123456", PhoneOptions{DefaultRegion: "US"},
+			PhoneNumber{"+447911123456", "GB", "44", "7911123456", PhoneMobile}, ""},
+		{"+44 7911 123456", PhoneOptions{DefaultRegion: "NL"},
+			PhoneNumber{"+447911123456", "GB", "44", "7911123456", PhoneMobile}, ""},
+		{"0612", PhoneOptions{DefaultRegion: "NL"}, PhoneNumber{}, MessagePhone},
+
+		// Restrictions.
+		{"+44 7911 123456", PhoneOptions{Countries: []string{"NL", "BE"}},
+			PhoneNumber{}, fmt.Sprintf(MessagePhoneCountry, "NL, BE")},
+		{"+421 912 123 456", PhoneOptions{Countries: []string{"NL"}},
+			PhoneNumber{}, fmt.Sprintf(MessagePhoneCountry, "NL")},
+		{"+46 70 123 45 67", PhoneOptions{Countries: []string{"SE"}},
+			PhoneNumber{"+46701234567", "SE", "46", "701234567", 0}, ""},
+		{"+32 470 12 34 56", PhoneOptions{Countries: []string{"nl", "be"}},
+			PhoneNumber{"+32470123456", "BE", "32", "470123456", PhoneMobile}, ""},
+		{"+31 20 123 4567", PhoneOptions{Types: PhoneMobile},
+			PhoneNumber{}, fmt.Sprintf(MessagePhoneType, "mobile")},
+		{"+1 212 555 1234", PhoneOptions{Types: PhoneMobile},
+			PhoneNumber{"+12125551234", "US", "1", "2125551234", PhoneFixed | PhoneMobile}, ""},
+		{"+49 800 1234567", PhoneOptions{Types: PhoneMobile | PhoneFixed},
+			PhoneNumber{}, fmt.Sprintf(MessagePhoneType, "mobile or landline")},
+		{"+421 912 123 456", PhoneOptions{Types: PhoneMobile},
+			PhoneNumber{}, fmt.Sprintf(MessagePhoneType, "mobile")},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			got := v.PhoneWithOptions("phone", tt.in, tt.opts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+
+			var wantErr []string
+			if tt.wantErr != "" {
+				wantErr = []string{tt.wantErr}
+			}
+			if !reflect.DeepEqual(v.Errors["phone"], wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["phone"], wantErr)
+			}
+		})
+	}
+}
+
+func TestPhoneWithOptionsPanic(t *testing.T) {
+	tests := []struct {
+		opts PhoneOptions
+		want string
+	}{
+		{PhoneOptions{DefaultRegion: "SE"}, `validate: no phone number metadata for DefaultRegion "SE"`},
+		{PhoneOptions{DefaultRegion: "XX"}, `validate: no phone number metadata for DefaultRegion "XX"`},
+		{PhoneOptions{Countries: []string{"NL", "XX"}}, `validate: unknown country "XX" in PhoneOptions.Countries`},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			defer func() {
+				got := recover()
+				if got != tt.want {
+					t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+				}
+			}()
+			v := New()
+			v.PhoneWithOptions("phone", "", tt.opts)
+		})
+	}
+}
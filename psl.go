@@ -0,0 +1,276 @@
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed" // For the Public Suffix List.
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/idna"
+)
+
+// The Public Suffix List from https://publicsuffix.org/list/; update with:
+//
+//	curl -o public_suffix_list.dat https://publicsuffix.org/list/public_suffix_list.dat
+//
+//go:embed public_suffix_list.dat
+var publicSuffixData []byte
+
+// PublicSuffixList is a parsed Public Suffix List (PSL), which lists the
+// domains under which anyone can register names, such as "com", "co.uk", or
+// "github.io".
+//
+// Rules are split in ICANN suffixes (from the registries, such as "co.uk"),
+// and private suffixes (submitted by the domain owners, such as "github.io").
+type PublicSuffixList struct {
+	// Values indicate if the rule is in the ICANN section.
+	rules      map[string]bool
+	wildcards  map[string]bool
+	exceptions map[string]bool
+}
+
+var (
+	publicSuffixMu   sync.RWMutex
+	publicSuffixList *PublicSuffixList
+)
+
+// DefaultPublicSuffixList gets the Public Suffix List that is used if no list
+// is given in the options.
+//
+// This is the list embedded in this package, unless it was replaced with
+// SetPublicSuffixList().
+func DefaultPublicSuffixList() *PublicSuffixList {
+	publicSuffixMu.RLock()
+	l := publicSuffixList
+	publicSuffixMu.RUnlock()
+	if l != nil {
+		return l
+	}
+
+	publicSuffixMu.Lock()
+	defer publicSuffixMu.Unlock()
+	if publicSuffixList == nil {
+		var err error
+		publicSuffixList, err = ParsePublicSuffixList(bytes.NewReader(publicSuffixData))
+		if err != nil {
+			panic(fmt.Sprintf("validate: parsing embedded public suffix list: %s", err))
+		}
+	}
+	return publicSuffixList
+}
+
+// SetPublicSuffixList replaces the default Public Suffix List, for example
+// with a more recent list loaded at runtime.
+func SetPublicSuffixList(l *PublicSuffixList) {
+	publicSuffixMu.Lock()
+	publicSuffixList = l
+	publicSuffixMu.Unlock()
+}
+
+// ParsePublicSuffixList parses a list in the format of
+// https://publicsuffix.org/list/public_suffix_list.dat
+func ParsePublicSuffixList(r io.Reader) (*PublicSuffixList, error) {
+	l := &PublicSuffixList{
+		rules:      make(map[string]bool),
+		wildcards:  make(map[string]bool),
+		exceptions: make(map[string]bool),
+	}
+
+	var (
+		scanner = bufio.NewScanner(r)
+		icann   bool
+		n       int
+	)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.Contains(line, "===BEGIN ICANN DOMAINS==="):
+			icann = true
+			continue
+		case strings.Contains(line, "===END ICANN DOMAINS==="):
+			icann = false
+			continue
+		case line == "" || strings.HasPrefix(line, "//"):
+			continue
+		}
+
+		// Only the first field is used, but lines may have trailing comments.
+		line = strings.Fields(line)[0]
+
+		m := l.rules
+		switch {
+		case strings.HasPrefix(line, "!"):
+			m, line = l.exceptions, line[1:]
+		case strings.HasPrefix(line, "*."):
+			m, line = l.wildcards, line[2:]
+		}
+
+		rule, err := idna.ToASCII(strings.ToLower(line))
+		if err != nil || strings.Contains(rule, "*") || hasEmptyLabel(rule) {
+			return nil, fmt.Errorf("validate: invalid public suffix rule %q", scanner.Text())
+		}
+		m[rule] = icann
+		n++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errors.New("validate: no rules in public suffix list")
+	}
+	return l, nil
+}
+
+// publicSuffix gets the number of labels of the public suffix of the domain,
+// and if the rule is from the ICANN section.
+//
+// listed is false if no rule matched and the default rule ("*", i.e. just the
+// top-level domain) was used.
+func (l *PublicSuffixList) publicSuffix(labels []string, icannOnly bool) (n int, icann, listed bool) {
+	// Exception rules take priority; the public suffix is the exception rule
+	// without its first label.
+	for i := range labels {
+		if ic, ok := l.exceptions[strings.Join(labels[i:], ".")]; ok && (ic || !icannOnly) {
+			return len(labels) - i - 1, ic, true
+		}
+	}
+
+	// The longest matching rule.
+	for i := range labels {
+		s := strings.Join(labels[i:], ".")
+		if i > 0 {
+			if ic, ok := l.wildcards[s]; ok && (ic || !icannOnly) {
+				return len(labels) - i + 1, ic, true
+			}
+		}
+		if ic, ok := l.rules[s]; ok && (ic || !icannOnly) {
+			return len(labels) - i, ic, true
+		}
+	}
+	return 1, false, false
+}
+
+// PublicSuffix gets the public suffix of a domain, e.g. "co.uk" for
+// "www.example.co.uk", and if it's an ICANN suffix.
+func (l *PublicSuffixList) PublicSuffix(domain string) (suffix string, icann bool) {
+	labels, ok := domainLabels(domain)
+	if !ok {
+		return "", false
+	}
+	n, icann, _ := l.publicSuffix(labels, false)
+	orig := strings.Split(normalizeDomain(domain), ".")
+	return strings.Join(orig[len(orig)-n:], "."), icann
+}
+
+// RegistrableDomain gets the registrable domain ("eTLD+1"), e.g. "example.co.uk"
+// for "www.example.co.uk".
+//
+// An error is returned if the domain is a public suffix itself.
+func (l *PublicSuffixList) RegistrableDomain(domain string) (string, error) {
+	labels, ok := domainLabels(domain)
+	if !ok {
+		return "", fmt.Errorf("validate: invalid domain %q", domain)
+	}
+	n, _, _ := l.publicSuffix(labels, false)
+	if n >= len(labels) {
+		return "", fmt.Errorf("validate: %q is a public suffix", domain)
+	}
+	orig := strings.Split(normalizeDomain(domain), ".")
+	return strings.Join(orig[len(orig)-n-1:], "."), nil
+}
+
+// normalizeDomain lowercases the domain and removes any trailing dot.
+func normalizeDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(domain, "."))
+}
+
+// domainLabels gets the punycode labels for a domain.
+func domainLabels(domain string) ([]string, bool) {
+	domain = normalizeDomain(domain)
+	if domain == "" {
+		return nil, false
+	}
+	ascii, err := idna.ToASCII(domain)
+	if err != nil {
+		return nil, false
+	}
+	if hasEmptyLabel(ascii) {
+		return nil, false
+	}
+	return strings.Split(ascii, "."), true
+}
+
+func hasEmptyLabel(domain string) bool {
+	return domain == "" || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") ||
+		strings.Contains(domain, "..")
+}
+
+// PublicSuffixOptions are the options for the RegistrableDomain() validator.
+type PublicSuffixOptions struct {
+	// Public Suffix List to use; the default is DefaultPublicSuffixList().
+	List *PublicSuffixList
+
+	// Require that the domain is a registrable domain, rather than a subdomain
+	// of one: "example.co.uk" is accepted, but "www.example.co.uk" isn't.
+	RequireRegistrable bool
+
+	// Only use the ICANN suffixes, and ignore the private suffixes; with this
+	// "github.io" is a registrable domain rather than a public suffix.
+	ICANNOnly bool
+
+	// Reject domains below a private suffix, such as "example.github.io".
+	RejectPrivate bool
+
+	// Reject domains with a top-level domain that isn't in the list.
+	RequireListed bool
+}
+
+// RegistrableDomain validates that the domain is valid (see Domain()) and that
+// it's not a public suffix such as "co.uk" or "github.io", according to the
+// Public Suffix List.
+//
+// The registrable part of the domain is returned, e.g. "example.co.uk" for
+// "www.example.co.uk".
+func (v *Validator) RegistrableDomain(key, value string, opts PublicSuffixOptions, message ...string) string {
+	if value == "" {
+		return ""
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) string {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+		return ""
+	}
+
+	labels, ok := domainLabels(value)
+	if !validDomain(strings.TrimSuffix(value, ".")) || !ok {
+		return appendMsg(MessageDomain)
+	}
+
+	l := opts.List
+	if l == nil {
+		l = DefaultPublicSuffixList()
+	}
+
+	n, icann, listed := l.publicSuffix(labels, opts.ICANNOnly)
+	orig := strings.Split(normalizeDomain(value), ".")
+	switch {
+	case opts.RequireListed && !listed:
+		return appendMsg(MessageDomainUnknownTLD)
+	case n >= len(labels):
+		return appendMsg(MessageDomainPublicSuffix)
+	case opts.RejectPrivate && listed && !icann:
+		return appendMsg(fmt.Sprintf(MessageDomainPrivateSuffix, strings.Join(orig[len(orig)-n:], ".")))
+	case opts.RequireRegistrable && len(labels) > n+1:
+		return appendMsg(MessageDomainRegistrable)
+	}
+
+	return strings.Join(orig[len(orig)-n-1:], ".")
+}
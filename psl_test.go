@@ -0,0 +1,139 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPublicSuffixList(t *testing.T) {
+	l := DefaultPublicSuffixList()
+
+	tests := []struct {
+		in          string
+		suffix      string
+		icann       bool
+		registrable string
+	}{
+		{"example.com", "com", true, "example.com"},
+		{"www.Example.COM.", "com", true, "example.com"},
+		{"com", "com", true, ""},
+		{"www.example.co.uk", "co.uk", true, "example.co.uk"},
+		{"co.uk", "co.uk", true, ""},
+		{"foo.github.io", "github.io", false, "foo.github.io"},
+		{"github.io", "github.io", false, ""},
+		{"a.b.example.ck", "example.ck", true, "b.example.ck"},
+		{"www.ck", "ck", true, "www.ck"},
+		{"www.city.kawasaki.jp", "kawasaki.jp", true, "city.kawasaki.jp"},
+		{"a.b.kawasaki.jp", "b.kawasaki.jp", true, "a.b.kawasaki.jp"},
+		{"example.公司.cn", "公司.cn", true, "example.公司.cn"},
+		{"example.xn--55qx5d.cn", "xn--55qx5d.cn", true, "example.xn--55qx5d.cn"},
+		{"example.notatld", "notatld", false, "example.notatld"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			suffix, icann := l.PublicSuffix(tt.in)
+			if suffix != tt.suffix || icann != tt.icann {
+				t.Errorf("PublicSuffix\nout:  %#v %t\nwant: %#v %t\n", suffix, icann, tt.suffix, tt.icann)
+			}
+
+			reg, err := l.RegistrableDomain(tt.in)
+			if (err != nil) != (tt.registrable == "") {
+				t.Errorf("RegistrableDomain: wrong error: %v", err)
+			}
+			if reg != tt.registrable {
+				t.Errorf("RegistrableDomain\nout:  %#v\nwant: %#v\n", reg, tt.registrable)
+			}
+		})
+	}
+}
+
+func TestParsePublicSuffixList(t *testing.T) {
+	l, err := ParsePublicSuffixList(strings.NewReader(`
+// ===BEGIN ICANN DOMAINS===
+com
+example.com  // comment
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+*.hosted.com
+!www.hosted.com
+// ===END PRIVATE DOMAINS===
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for in, want := range map[string]string{
+		"a.example.com":    "a.example.com",
+		"a.b.hosted.com":   "a.b.hosted.com",
+		"www.hosted.com":   "www.hosted.com",
+		"a.www.hosted.com": "www.hosted.com",
+	} {
+		if out, _ := l.RegistrableDomain(in); out != want {
+			t.Errorf("%s\nout:  %#v\nwant: %#v\n", in, out, want)
+		}
+	}
+
+	for _, in := range []string{"", "// only comments", "*.*.com", "a..b"} {
+		if _, err := ParsePublicSuffixList(strings.NewReader(in)); err == nil {
+			t.Errorf("no error for %q", in)
+		}
+	}
+
+	t.Run("set default", func(t *testing.T) {
+		def := DefaultPublicSuffixList()
+		defer SetPublicSuffixList(def)
+
+		SetPublicSuffixList(l)
+		v := New()
+		v.RegistrableDomain("k", "example.com", PublicSuffixOptions{})
+		if want := map[string][]string{"k": {"cannot be a public suffix"}}; !reflect.DeepEqual(v.Errors, want) {
+			t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, want)
+		}
+	})
+}
+
+func TestRegistrableDomain(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    PublicSuffixOptions
+		want    string
+		wantErr string
+	}{
+		{"", PublicSuffixOptions{}, "", ""},
+		{"example.com", PublicSuffixOptions{}, "example.com", ""},
+		{"www.example.co.uk", PublicSuffixOptions{}, "example.co.uk", ""},
+		{"foo.github.io", PublicSuffixOptions{}, "foo.github.io", ""},
+		{"github.io", PublicSuffixOptions{ICANNOnly: true}, "github.io", ""},
+		{"www.foo.github.io", PublicSuffixOptions{ICANNOnly: true}, "github.io", ""},
+		{"example.notatld", PublicSuffixOptions{}, "example.notatld", ""},
+
+		{"example", PublicSuffixOptions{}, "", "must be a valid domain"},
+		{"exa mple.com", PublicSuffixOptions{}, "", "must be a valid domain"},
+		{"co.uk", PublicSuffixOptions{}, "", "cannot be a public suffix"},
+		{"github.io", PublicSuffixOptions{}, "", "cannot be a public suffix"},
+		{"foo.github.io", PublicSuffixOptions{RejectPrivate: true}, "", "cannot be a subdomain of ‘github.io’"},
+		{"www.example.co.uk", PublicSuffixOptions{RequireRegistrable: true}, "",
+			"must be a registrable domain, not a subdomain"},
+		{"example.notatld", PublicSuffixOptions{RequireListed: true}, "", "must have a known top-level domain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			out := v.RegistrableDomain("k", tt.in, tt.opts)
+
+			want := map[string][]string{}
+			if tt.wantErr != "" {
+				want["k"] = []string{tt.wantErr}
+			}
+			if !reflect.DeepEqual(v.Errors, want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, want)
+			}
+			if out != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+		})
+	}
+}
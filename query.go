@@ -0,0 +1,97 @@
+package validate
+
+import "sort"
+
+// Has reports if there are any errors for this key.
+func (v *Validator) Has(key string) bool {
+	return len(v.Errors[key]) > 0
+}
+
+// Get the errors for this key.
+func (v *Validator) Get(key string) []string {
+	return v.Errors[key]
+}
+
+// Keys gets all keys with errors, sorted alphabetically.
+func (v *Validator) Keys() []string {
+	keys := make([]string, 0, len(v.Errors))
+	for k := range v.Errors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Count gets the total number of errors for all keys.
+func (v *Validator) Count() int {
+	n := 0
+	for _, msgs := range v.Errors {
+		n += len(msgs)
+	}
+	return n
+}
+
+// Delete all errors for this key.
+func (v *Validator) Delete(key string) {
+	delete(v.Errors, key)
+	delete(v.paths, key)
+}
+
+// Prefix gets a new Validator with only the errors below the prefix, with the
+// prefix removed from the keys.
+//
+// The prefix is matched on path elements as added by Sub(), so "settings"
+// matches "settings.domain" but not "settingsDomain", and
+// Prefix("addresses[1]") gives errors for "addresses[1].city" as "city". Keys
+// that continue with an index keep it: Prefix("addresses") gives "[1].city".
+//
+// The prefix is also matched as a single field name, so that
+// Sub("settings.advanced", "", err) can be retrieved with
+// Prefix("settings.advanced").
+//
+// Errors for the prefix key itself are not included; use Get() for those.
+func (v *Validator) Prefix(prefix string) Validator {
+	prefixes := []Path{{PathField(prefix)}}
+	if pp, err := ParsePath(prefix); err == nil && len(pp) > 1 {
+		prefixes = append(prefixes, pp)
+	}
+
+	n := New()
+	n.keyMap = v.keyMap
+	for k, msgs := range v.Errors {
+		p := v.Path(k)
+		var rest Path
+		for _, pp := range prefixes {
+			if len(p) > len(pp) && p.HasPrefix(pp) {
+				rest = p[len(pp):]
+				break
+			}
+		}
+		if rest == nil {
+			continue
+		}
+
+		nk := rest.String()
+		n.setPath(nk, rest)
+		n.Errors[nk] = append(n.Errors[nk], msgs...)
+	}
+	return n
+}
+
+// Filter gets a new Validator with only the errors for which keep returns
+// true.
+func (v *Validator) Filter(keep func(key, message string) bool) Validator {
+	n := New()
+	n.keyMap = v.keyMap
+	for k, msgs := range v.Errors {
+		for _, m := range msgs {
+			if keep(k, m) {
+				n.Append(k, m)
+			}
+		}
+		if p, ok := v.paths[k]; ok && n.Has(k) {
+			n.setPath(k, p)
+		}
+	}
+	return n
+}
@@ -0,0 +1,141 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newQueryValidator() Validator {
+	v := New()
+	v.Required("name", "")
+	v.Append("name", "must be longer than 2 characters")
+	v.Append("settings", "invalid settings")
+	v.Append("settingsExtra", "oh noes")
+
+	s := New()
+	s.Required("domain", "")
+	s.Append("notify.email", "must be a valid email address")
+	v.Sub("settings", "", s)
+
+	a := New()
+	a.Required("city", "")
+	v.Sub("addresses", "1", a)
+	return v
+}
+
+func TestQuery(t *testing.T) {
+	v := newQueryValidator()
+
+	if !v.Has("name") || v.Has("nope") {
+		t.Error("Has")
+	}
+	if out, want := v.Get("name"), []string{"must be set", "must be longer than 2 characters"}; !reflect.DeepEqual(out, want) {
+		t.Errorf("Get\nout:  %#v\nwant: %#v\n", out, want)
+	}
+	if out := v.Get("nope"); out != nil {
+		t.Errorf("Get\nout:  %#v\nwant: nil\n", out)
+	}
+
+	wantKeys := []string{"addresses[1].city", "name", "settings", "settings.domain",
+		"settings.notify.email", "settingsExtra"}
+	if out := v.Keys(); !reflect.DeepEqual(out, wantKeys) {
+		t.Errorf("Keys\nout:  %#v\nwant: %#v\n", out, wantKeys)
+	}
+	if out := v.Count(); out != 7 {
+		t.Errorf("Count\nout:  %#v\nwant: %#v\n", out, 7)
+	}
+
+	v.Delete("name")
+	v.Delete("nope")
+	if v.Has("name") || v.Count() != 5 {
+		t.Errorf("Delete: %#v", v.Errors)
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   map[string][]string
+	}{
+		{"settings", map[string][]string{
+			"domain":       {"must be set"},
+			"notify.email": {"must be a valid email address"},
+		}},
+		{"settings.notify", map[string][]string{
+			"email": {"must be a valid email address"},
+		}},
+		{"addresses", map[string][]string{
+			"[1].city": {"must be set"},
+		}},
+		{"addresses[1]", map[string][]string{
+			"city": {"must be set"},
+		}},
+		{"name", map[string][]string{}},
+		{"nope", map[string][]string{}},
+		{"[broken", map[string][]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			v := newQueryValidator()
+			out := v.Prefix(tt.prefix)
+			if d := cmp.Diff(out.Errors, tt.want); d != "" {
+				t.Errorf("(-got +want)\n:%s", d)
+			}
+		})
+	}
+
+	t.Run("path", func(t *testing.T) {
+		v := New()
+		v.Sub("a.b", "", func() error {
+			s := New()
+			s.Append("c", "x")
+			return s
+		}())
+
+		if out := v.Prefix("a"); out.HasErrors() {
+			t.Errorf("matched on field with dot: %#v", out.Errors)
+		}
+		want := map[string][]string{"c": {"x"}}
+		if d := cmp.Diff(v.Prefix("a.b").Errors, want); d != "" {
+			t.Errorf("(-got +want)\n:%s", d)
+		}
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		v := New()
+		v.Sub("userSettings", "", func() error {
+			s := New()
+			s.Required("notifyEmail", "")
+			return s
+		}())
+		v.MapKeys(KeyMap{Case: SnakeCase})
+
+		want := "notify_email: must be set.\n"
+		if out := v.Prefix("userSettings"); out.String() != want {
+			t.Errorf("\nout:  %#v\nwant: %#v\n", out.String(), want)
+		}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	v := newQueryValidator()
+	out := v.Filter(func(key, msg string) bool {
+		return strings.HasPrefix(key, "settings") && msg != "must be set"
+	})
+
+	want := map[string][]string{
+		"settings":              {"invalid settings"},
+		"settingsExtra":         {"oh noes"},
+		"settings.notify.email": {"must be a valid email address"},
+	}
+	if d := cmp.Diff(out.Errors, want); d != "" {
+		t.Errorf("(-got +want)\n:%s", d)
+	}
+	if v.Count() != 7 {
+		t.Errorf("original modified: %#v", v.Errors)
+	}
+}
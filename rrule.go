@@ -0,0 +1,685 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a recurrence rule.
+type Frequency int
+
+// Frequencies, from most to least frequent.
+const (
+	FreqSecondly Frequency = iota + 1
+	FreqMinutely
+	FreqHourly
+	FreqDaily
+	FreqWeekly
+	FreqMonthly
+	FreqYearly
+)
+
+var frequencyNames = map[Frequency]string{
+	FreqSecondly: "SECONDLY",
+	FreqMinutely: "MINUTELY",
+	FreqHourly:   "HOURLY",
+	FreqDaily:    "DAILY",
+	FreqWeekly:   "WEEKLY",
+	FreqMonthly:  "MONTHLY",
+	FreqYearly:   "YEARLY",
+}
+
+// String gets the RFC 5545 name, e.g. "WEEKLY".
+func (f Frequency) String() string { return frequencyNames[f] }
+
+var weekdayNames = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// RRuleWeekday is a BYDAY value of a recurrence rule, such as "MO" or "-1FR"
+// (the last Friday).
+type RRuleWeekday struct {
+	// Occurrence within the month or year, or 0 for every occurrence.
+	N   int
+	Day time.Weekday
+}
+
+// RRule is a parsed RFC 5545 recurrence rule, such as
+// "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+type RRule struct {
+	Freq      Frequency
+	Interval  int // Always at least 1.
+	Count     int
+	Until     time.Time
+	WeekStart time.Weekday
+
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []RRuleWeekday
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []int
+	BySetPos   []int
+
+	// UNTIL was a date or a local time without "Z", and is interpreted in the
+	// time zone of the start.
+	untilFloating bool
+	untilDate     bool
+}
+
+// ParseRRule parses an RFC 5545 recurrence rule, such as
+// "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", with an optional "RRULE:" prefix.
+//
+// Both the grammar and the constraints between parts are validated: for
+// example COUNT and UNTIL can't both be set, and BYYEARDAY can't be used with
+// FREQ=MONTHLY.
+func ParseRRule(s string) (*RRule, error) {
+	r, reason := parseRRule(s)
+	if reason != "" {
+		return nil, fmt.Errorf("validate: invalid RRULE %q: %s", s, reason)
+	}
+	return r, nil
+}
+
+func parseRRule(s string) (*RRule, string) {
+	s = strings.TrimSpace(s)
+	if len(s) > 6 && strings.EqualFold(s[:6], "RRULE:") {
+		s = s[6:]
+	}
+
+	r := &RRule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+		if !ok || name == "" || value == "" {
+			return nil, fmt.Sprintf("invalid part ‘%s’", part)
+		}
+		if seen[name] {
+			return nil, fmt.Sprintf("duplicate part ‘%s’", name)
+		}
+		seen[name] = true
+
+		var reason string
+		value = strings.ToUpper(value)
+		switch name {
+		case "FREQ":
+			for f, n := range frequencyNames {
+				if n == value {
+					r.Freq = f
+				}
+			}
+			if r.Freq == 0 {
+				reason = fmt.Sprintf("invalid FREQ ‘%s’", value)
+			}
+		case "INTERVAL":
+			r.Interval, reason = rruleInt(name, value, 1, 0, false)
+		case "COUNT":
+			r.Count, reason = rruleInt(name, value, 1, 0, false)
+		case "UNTIL":
+			reason = r.parseUntil(value)
+		case "WKST":
+			d, ok := weekdayNames[value]
+			if !ok {
+				reason = fmt.Sprintf("invalid WKST ‘%s’", value)
+			}
+			r.WeekStart = d
+		case "BYSECOND":
+			r.BySecond, reason = rruleInts(name, value, 0, 60, false)
+		case "BYMINUTE":
+			r.ByMinute, reason = rruleInts(name, value, 0, 59, false)
+		case "BYHOUR":
+			r.ByHour, reason = rruleInts(name, value, 0, 23, false)
+		case "BYDAY":
+			r.ByDay, reason = rruleWeekdays(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, reason = rruleInts(name, value, 1, 31, true)
+		case "BYYEARDAY":
+			r.ByYearDay, reason = rruleInts(name, value, 1, 366, true)
+		case "BYWEEKNO":
+			r.ByWeekNo, reason = rruleInts(name, value, 1, 53, true)
+		case "BYMONTH":
+			r.ByMonth, reason = rruleInts(name, value, 1, 12, false)
+		case "BYSETPOS":
+			r.BySetPos, reason = rruleInts(name, value, 1, 366, true)
+		default:
+			reason = fmt.Sprintf("unknown part ‘%s’", name)
+		}
+		if reason != "" {
+			return nil, reason
+		}
+	}
+
+	return r, r.check()
+}
+
+// check the constraints between parts.
+func (r *RRule) check() string {
+	switch {
+	case r.Freq == 0:
+		return "FREQ is required"
+	case r.Count > 0 && !r.Until.IsZero():
+		return "COUNT and UNTIL cannot both be set"
+	case len(r.ByMonthDay) > 0 && r.Freq == FreqWeekly:
+		return "BYMONTHDAY cannot be used with FREQ=WEEKLY"
+	case len(r.ByYearDay) > 0 && (r.Freq == FreqDaily || r.Freq == FreqWeekly || r.Freq == FreqMonthly):
+		return fmt.Sprintf("BYYEARDAY cannot be used with FREQ=%s", r.Freq)
+	case len(r.ByWeekNo) > 0 && r.Freq != FreqYearly:
+		return fmt.Sprintf("BYWEEKNO cannot be used with FREQ=%s", r.Freq)
+	case len(r.BySetPos) > 0 && len(r.BySecond)+len(r.ByMinute)+len(r.ByHour)+len(r.ByDay)+
+		len(r.ByMonthDay)+len(r.ByYearDay)+len(r.ByWeekNo)+len(r.ByMonth) == 0:
+		return "BYSETPOS must be used with another BYxxx part"
+	}
+
+	for _, d := range r.ByDay {
+		if d.N == 0 {
+			continue
+		}
+		if r.Freq != FreqMonthly && r.Freq != FreqYearly {
+			return fmt.Sprintf("BYDAY cannot have a number with FREQ=%s", r.Freq)
+		}
+		if r.Freq == FreqYearly && len(r.ByWeekNo) > 0 {
+			return "BYDAY cannot have a number with BYWEEKNO"
+		}
+		if (r.Freq == FreqMonthly || len(r.ByMonth) > 0) && (d.N > 5 || d.N < -5) {
+			return fmt.Sprintf("BYDAY value ‘%d’ is out of range", d.N)
+		}
+	}
+	return ""
+}
+
+func (r *RRule) parseUntil(value string) string {
+	var err error
+	switch {
+	case len(value) == 8:
+		r.Until, err = time.Parse("20060102", value)
+		r.untilDate, r.untilFloating = true, true
+	case strings.HasSuffix(value, "Z"):
+		r.Until, err = time.Parse("20060102T150405Z", value)
+	default:
+		r.Until, err = time.Parse("20060102T150405", value)
+		r.untilFloating = true
+	}
+	if err != nil {
+		return fmt.Sprintf("invalid UNTIL ‘%s’", value)
+	}
+	return ""
+}
+
+// rruleInt parses a single integer between min and max; a max of 0 means there
+// is no upper limit. If signed is set the value can also be between -max and
+// -min.
+func rruleInt(name, value string, min, max int, signed bool) (int, string) {
+	n, err := strconv.Atoi(value)
+	abs := n
+	if signed && n < 0 {
+		abs = -n
+	}
+	if err != nil || abs < min || (max > 0 && abs > max) || (!signed && strings.HasPrefix(value, "-")) {
+		return 0, fmt.Sprintf("%s value ‘%s’ is out of range", name, value)
+	}
+	return n, ""
+}
+
+func rruleInts(name, value string, min, max int, signed bool) ([]int, string) {
+	values := strings.Split(value, ",")
+	list := make([]int, 0, len(values))
+	for _, v := range values {
+		n, reason := rruleInt(name, v, min, max, signed)
+		if reason != "" {
+			return nil, reason
+		}
+		list = append(list, n)
+	}
+	return list, ""
+}
+
+func rruleWeekdays(value string) ([]RRuleWeekday, string) {
+	values := strings.Split(value, ",")
+	list := make([]RRuleWeekday, 0, len(values))
+	for _, v := range values {
+		if len(v) < 2 {
+			return nil, fmt.Sprintf("invalid BYDAY ‘%s’", v)
+		}
+		d, ok := weekdayNames[v[len(v)-2:]]
+		if !ok {
+			return nil, fmt.Sprintf("invalid BYDAY ‘%s’", v)
+		}
+		wd := RRuleWeekday{Day: d}
+		if n := v[:len(v)-2]; n != "" {
+			var reason string
+			wd.N, reason = rruleInt("BYDAY", n, 1, 53, true)
+			if reason != "" {
+				return nil, reason
+			}
+		}
+		list = append(list, wd)
+	}
+	return list, ""
+}
+
+// Maximum number of periods (e.g. years for FREQ=YEARLY) that Expand() looks at.
+const maxRRulePeriods = 100000
+
+// Expand gets the first n occurrences of the rule, starting at start (DTSTART).
+//
+// Fewer occurrences are returned if the rule ends sooner because of COUNT or
+// UNTIL, or if no more occurrences are found in the next 400 years or 100,000
+// periods (e.g. for "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", which never
+// occurs).
+//
+// Times that don't exist because of a DST transition are moved forward, as in
+// RFC 5545: 02:30 is 03:30 on the day the clock goes from 02:00 to 03:00. Times
+// that occur twice are only used once.
+//
+// As in most calendar applications, the start is only an occurrence if it
+// matches the rule.
+func (r *RRule) Expand(start time.Time, n int) []time.Time {
+	if r.Count > 0 && r.Count < n {
+		n = r.Count
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	loc := start.Location()
+	until := r.Until
+	switch {
+	case r.untilDate:
+		until = time.Date(until.Year(), until.Month(), until.Day(), 23, 59, 59, 999999999, loc)
+	case r.untilFloating:
+		until = time.Date(until.Year(), until.Month(), until.Day(),
+			until.Hour(), until.Minute(), until.Second(), 0, loc)
+	}
+
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	x := r.expander(start)
+	var (
+		occurrences []time.Time
+		done        bool
+	)
+	for k := 0; k < maxRRulePeriods && !done; k++ {
+		days, hour, minute, second := x.period(k * interval)
+		// The Gregorian calendar repeats every 400 years.
+		if len(days) == 0 || days[0].Year() > start.Year()+400 {
+			break
+		}
+
+		x.each(days, hour, minute, second, func(t time.Time) bool {
+			if !until.IsZero() && t.After(until) {
+				done = true
+				return false
+			}
+			// Skip the times before the start, and times that are the same
+			// as the previous one after moving them out of a DST gap.
+			if t.Before(start) || (len(occurrences) > 0 && !t.After(occurrences[len(occurrences)-1])) {
+				return true
+			}
+			occurrences = append(occurrences, t)
+			done = len(occurrences) == n
+			return !done
+		})
+	}
+	return occurrences
+}
+
+// rruleExpander is a copy of the rule with the defaults from the start filled
+// in.
+type rruleExpander struct {
+	RRule
+	start time.Time
+
+	// Hours, minutes, and seconds for each day: the BYxxx values, or the
+	// time of the start.
+	hours, minutes, seconds []int
+}
+
+func (r *RRule) expander(start time.Time) *rruleExpander {
+	x := &rruleExpander{RRule: *r, start: start}
+
+	// If there are no BYxxx parts to expand the period the date of the start
+	// is used; e.g. "FREQ=MONTHLY" occurs on the day of the month of the
+	// start.
+	if len(x.ByWeekNo)+len(x.ByYearDay)+len(x.ByMonthDay)+len(x.ByDay) == 0 {
+		switch x.Freq {
+		case FreqYearly:
+			if len(x.ByMonth) == 0 {
+				x.ByMonth = []int{int(start.Month())}
+			}
+			x.ByMonthDay = []int{start.Day()}
+		case FreqMonthly:
+			x.ByMonthDay = []int{start.Day()}
+		case FreqWeekly:
+			x.ByDay = []RRuleWeekday{{Day: start.Weekday()}}
+		}
+	}
+	if x.Freq == FreqYearly && len(x.ByWeekNo) > 0 && len(x.ByDay) == 0 &&
+		len(x.ByMonthDay) == 0 && len(x.ByYearDay) == 0 {
+		x.ByDay = []RRuleWeekday{{Day: start.Weekday()}}
+	}
+
+	x.hours = sortedOr(x.ByHour, start.Hour())
+	x.minutes = sortedOr(x.ByMinute, start.Minute())
+	x.seconds = sortedOr(x.BySecond, start.Second())
+	return x
+}
+
+// sortedOr gets a sorted copy of the list, or just def if it's empty.
+func sortedOr(list []int, def int) []int {
+	if len(list) == 0 {
+		return []int{def}
+	}
+	s := append([]int(nil), list...)
+	sort.Ints(s)
+	return s
+}
+
+// period gets the days in the k-th period after the start. For frequencies
+// below daily it also gets the hour, minute, and second of the period, or -1
+// if they should be expanded.
+func (x *rruleExpander) period(k int) (days []time.Time, hour, minute, second int) {
+	s, loc := x.start, x.start.Location()
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, loc) }
+	hour, minute, second = -1, -1, -1
+
+	var first time.Time
+	n := 1
+	switch x.Freq {
+	case FreqYearly:
+		first = date(s.Year()+k, 1, 1)
+		n = daysInYear(first.Year())
+		if len(x.ByWeekNo) > 0 {
+			// Weeks can start in the previous year.
+			first = firstWeek(s.Year()+k, x.WeekStart, loc)
+			n = daysBetween(first, firstWeek(s.Year()+k+1, x.WeekStart, loc))
+		}
+	case FreqMonthly:
+		first = date(s.Year(), s.Month()+time.Month(k), 1)
+		n = daysIn(first.Month(), first.Year())
+	case FreqWeekly:
+		offset := (int(s.Weekday()) - int(x.WeekStart) + 7) % 7
+		first, n = date(s.Year(), s.Month(), s.Day()-offset+7*k), 7
+	case FreqDaily:
+		first = date(s.Year(), s.Month(), s.Day()+k)
+	default:
+		// Use UTC so that the hour, minute, and second are not affected by
+		// DST transitions.
+		var t time.Time
+		switch x.Freq {
+		case FreqHourly:
+			t = time.Date(s.Year(), s.Month(), s.Day(), s.Hour()+k, 0, 0, 0, time.UTC)
+			hour = t.Hour()
+		case FreqMinutely:
+			t = time.Date(s.Year(), s.Month(), s.Day(), s.Hour(), s.Minute()+k, 0, 0, time.UTC)
+			hour, minute = t.Hour(), t.Minute()
+		case FreqSecondly:
+			t = time.Date(s.Year(), s.Month(), s.Day(), s.Hour(), s.Minute(), s.Second()+k, 0, time.UTC)
+			hour, minute, second = t.Hour(), t.Minute(), t.Second()
+		}
+		first = date(t.Year(), t.Month(), t.Day())
+	}
+
+	days = make([]time.Time, 0, n)
+	for i := 0; i < n; i++ {
+		days = append(days, date(first.Year(), first.Month(), first.Day()+i))
+	}
+	return days, hour, minute, second
+}
+
+// each calls fn for the occurrences in the period, in order, until it returns
+// false.
+//
+// The times are generated one by one, as rules such as
+// "FREQ=YEARLY;BYHOUR=0,...,23;BYMINUTE=0,...,59;BYSECOND=0,...,59" have
+// millions of times in a period.
+func (x *rruleExpander) each(days []time.Time, hour, minute, second int, fn func(time.Time) bool) {
+	// The hour, minute, and second of the period are limited by the BYxxx
+	// parts, rather than expanded.
+	limit := func(v int, by, expanded []int) []int {
+		switch {
+		case v == -1:
+			return expanded
+		case len(by) > 0 && !includeInt(by, v):
+			return nil
+		default:
+			return []int{v}
+		}
+	}
+	hours := limit(hour, x.ByHour, x.hours)
+	minutes := limit(minute, x.ByMinute, x.minutes)
+	seconds := limit(second, x.BySecond, x.seconds)
+
+	var matched []time.Time
+	for _, d := range days {
+		if x.matchDay(d) {
+			matched = append(matched, d)
+		}
+	}
+
+	// The i-th time in the period.
+	perDay, perHour := len(hours)*len(minutes)*len(seconds), len(minutes)*len(seconds)
+	total := len(matched) * perDay
+	at := func(i int) time.Time {
+		d := matched[i/perDay]
+		i %= perDay
+		return localDate(d.Year(), d.Month(), d.Day(),
+			hours[i/perHour], minutes[i%perHour/len(seconds)], seconds[i%len(seconds)], d.Location())
+	}
+
+	if len(x.BySetPos) > 0 {
+		var set []int
+		for _, p := range x.BySetPos {
+			i := p - 1
+			if p < 0 {
+				i = total + p
+			}
+			if i >= 0 && i < total && !includeInt(set, i) {
+				set = append(set, i)
+			}
+		}
+		sort.Ints(set)
+		for _, i := range set {
+			if !fn(at(i)) {
+				return
+			}
+		}
+		return
+	}
+
+	// Skip the days before the start, as there can be many times on them.
+	startDay := time.Date(x.start.Year(), x.start.Month(), x.start.Day(), 0, 0, 0, 0, x.start.Location())
+	for i := 0; i < total; i++ {
+		if i%perDay == 0 && matched[i/perDay].Before(startDay) {
+			i += perDay - 1
+			continue
+		}
+		if !fn(at(i)) {
+			return
+		}
+	}
+}
+
+// localDate is like time.Date(), but times that don't exist because of a DST
+// transition are moved forward by the length of the gap, as in RFC 5545: 02:30
+// is 03:30 on the day the clock goes from 02:00 to 03:00.
+func localDate(year int, month time.Month, day, hour, min, sec int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, min, sec, 0, loc)
+	if t.Hour() == hour && t.Minute() == min && t.Day() == day {
+		return t
+	}
+
+	// time.Date() used the offset from one side of the gap; the time with the
+	// offset from before the gap is the later of the two.
+	_, offset := t.Zone()
+	u := time.Date(year, month, day, hour, min, sec, 0, time.UTC).Add(-time.Duration(offset) * time.Second).In(loc)
+	if u.After(t) {
+		return u
+	}
+	return t
+}
+
+// matchDay reports if the day matches the BYxxx parts for days.
+func (x *rruleExpander) matchDay(d time.Time) bool {
+	if len(x.ByMonth) > 0 && !includeInt(x.ByMonth, int(d.Month())) {
+		return false
+	}
+	if len(x.ByWeekNo) > 0 {
+		week, weeks := weekNumber(d, x.WeekStart)
+		if !includeInt(x.ByWeekNo, week) && !includeInt(x.ByWeekNo, week-weeks-1) {
+			return false
+		}
+	}
+	if len(x.ByYearDay) > 0 {
+		yearDays := daysInYear(d.Year())
+		if !includeInt(x.ByYearDay, d.YearDay()) && !includeInt(x.ByYearDay, d.YearDay()-yearDays-1) {
+			return false
+		}
+	}
+	if len(x.ByMonthDay) > 0 {
+		monthDays := daysIn(d.Month(), d.Year())
+		if !includeInt(x.ByMonthDay, d.Day()) && !includeInt(x.ByMonthDay, d.Day()-monthDays-1) {
+			return false
+		}
+	}
+	if len(x.ByDay) > 0 {
+		return x.matchWeekday(d)
+	}
+	return true
+}
+
+func (x *rruleExpander) matchWeekday(d time.Time) bool {
+	// Numbered weekdays are within the month for FREQ=MONTHLY or if BYMONTH is
+	// set, and within the year otherwise.
+	day, days := d.YearDay(), daysInYear(d.Year())
+	if x.Freq == FreqMonthly || len(x.ByMonth) > 0 {
+		day, days = d.Day(), daysIn(d.Month(), d.Year())
+	}
+
+	for _, wd := range x.ByDay {
+		if wd.Day != d.Weekday() {
+			continue
+		}
+		if wd.N == 0 || wd.N == (day-1)/7+1 || wd.N == -((days-day)/7+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// weekNumber gets the week number of the day as in RFC 5545 (and ISO 8601):
+// week 1 is the first week with at least 4 days in the year. It also returns
+// the number of weeks in that year.
+func weekNumber(d time.Time, weekStart time.Weekday) (week, weeks int) {
+	year := d.Year()
+	switch {
+	case d.Before(firstWeek(year, weekStart, d.Location())):
+		year--
+	case !d.Before(firstWeek(year+1, weekStart, d.Location())):
+		year++
+	}
+	start, next := firstWeek(year, weekStart, d.Location()), firstWeek(year+1, weekStart, d.Location())
+	return daysBetween(start, d)/7 + 1, daysBetween(start, next) / 7
+}
+
+// firstWeek gets the start of week 1 of the year, which is the week with 4
+// January.
+func firstWeek(year int, weekStart time.Weekday, loc *time.Location) time.Time {
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, loc)
+	return jan4.AddDate(0, 0, -((int(jan4.Weekday()) - int(weekStart) + 7) % 7))
+}
+
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
+
+func daysIn(m time.Month, year int) int {
+	return time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func daysInYear(year int) int {
+	return time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+func includeInt(list []int, n int) bool {
+	for _, l := range list {
+		if l == n {
+			return true
+		}
+	}
+	return false
+}
+
+// RRuleOptions are the options for the RRule() validator.
+type RRuleOptions struct {
+	// Maximum number of occurrences; if set the rule must have a COUNT or
+	// UNTIL.
+	MaxCount int
+
+	// Start (DTSTART) of the recurrence, which is used to count the
+	// occurrences for rules with UNTIL; the default is the current time.
+	Start time.Time
+
+	// Most frequent FREQ that is allowed, e.g. FreqDaily to reject rules that
+	// repeat every hour. The default of 0 allows all frequencies.
+	MinFrequency Frequency
+}
+
+// RRule validates that the string is a valid RFC 5545 recurrence rule, such as
+// "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"; see ParseRRule().
+//
+// The parsed rule is returned, which can be used to get the occurrences with
+// Expand().
+func (v *Validator) RRule(key, value string, opts RRuleOptions, message ...string) *RRule {
+	if value == "" {
+		return nil
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) *RRule {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+		return nil
+	}
+
+	r, reason := parseRRule(value)
+	if reason != "" {
+		return appendMsg(fmt.Sprintf(MessageRRule, reason))
+	}
+
+	if opts.MinFrequency > 0 && r.Freq < opts.MinFrequency {
+		return appendMsg(fmt.Sprintf(MessageRRuleFrequency, strings.ToLower(opts.MinFrequency.String())))
+	}
+
+	if opts.MaxCount > 0 {
+		switch {
+		case r.Count == 0 && r.Until.IsZero():
+			return appendMsg(MessageRRuleEnd)
+		case r.Count > opts.MaxCount:
+			return appendMsg(fmt.Sprintf(MessageRRuleCount, opts.MaxCount))
+		case !r.Until.IsZero():
+			start := opts.Start
+			if start.IsZero() {
+				start = time.Now()
+			}
+			if len(r.Expand(start, opts.MaxCount+1)) > opts.MaxCount {
+				return appendMsg(fmt.Sprintf(MessageRRuleCount, opts.MaxCount))
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,276 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    *RRule
+		wantErr string
+	}{
+		{"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", &RRule{
+			Freq: FreqWeekly, Interval: 1, Count: 10, WeekStart: time.Monday,
+			ByDay: []RRuleWeekday{{Day: time.Monday}, {Day: time.Wednesday}},
+		}, ""},
+		{"RRULE:freq=monthly;byday=-1fr;interval=2;wkst=su", &RRule{
+			Freq: FreqMonthly, Interval: 2, WeekStart: time.Sunday,
+			ByDay: []RRuleWeekday{{N: -1, Day: time.Friday}},
+		}, ""},
+		{"FREQ=YEARLY;BYMONTH=1,7;BYMONTHDAY=+1,-1;UNTIL=20301231T235959Z", &RRule{
+			Freq: FreqYearly, Interval: 1, WeekStart: time.Monday,
+			Until:   time.Date(2030, 12, 31, 23, 59, 59, 0, time.UTC),
+			ByMonth: []int{1, 7}, ByMonthDay: []int{1, -1},
+		}, ""},
+		{"FREQ=DAILY;BYHOUR=9,17;BYMINUTE=0,30;BYSECOND=0", &RRule{
+			Freq: FreqDaily, Interval: 1, WeekStart: time.Monday,
+			ByHour: []int{9, 17}, ByMinute: []int{0, 30}, BySecond: []int{0},
+		}, ""},
+
+		// Grammar.
+		{"", nil, "invalid part ‘’"},
+		{"COUNT=10", nil, "FREQ is required"},
+		{"FREQ=WEEKLY;", nil, "invalid part ‘’"},
+		{"FREQ=WEEKLY;COUNT", nil, "invalid part ‘COUNT’"},
+		{"FREQ=FORTNIGHTLY", nil, "invalid FREQ ‘FORTNIGHTLY’"},
+		{"FREQ=WEEKLY;FREQ=DAILY", nil, "duplicate part ‘FREQ’"},
+		{"FREQ=WEEKLY;X-NAME=1", nil, "unknown part ‘X-NAME’"},
+		{"FREQ=WEEKLY;COUNT=0", nil, "COUNT value ‘0’ is out of range"},
+		{"FREQ=WEEKLY;INTERVAL=-1", nil, "INTERVAL value ‘-1’ is out of range"},
+		{"FREQ=WEEKLY;UNTIL=2020", nil, "invalid UNTIL ‘2020’"},
+		{"FREQ=WEEKLY;WKST=XX", nil, "invalid WKST ‘XX’"},
+		{"FREQ=WEEKLY;BYDAY=MO,XX", nil, "invalid BYDAY ‘XX’"},
+		{"FREQ=WEEKLY;BYDAY=", nil, "invalid part ‘BYDAY=’"},
+
+		// Ranges.
+		{"FREQ=DAILY;BYHOUR=24", nil, "BYHOUR value ‘24’ is out of range"},
+		{"FREQ=DAILY;BYMINUTE=-1", nil, "BYMINUTE value ‘-1’ is out of range"},
+		{"FREQ=MONTHLY;BYMONTHDAY=0", nil, "BYMONTHDAY value ‘0’ is out of range"},
+		{"FREQ=MONTHLY;BYMONTHDAY=-32", nil, "BYMONTHDAY value ‘-32’ is out of range"},
+		{"FREQ=YEARLY;BYMONTH=13", nil, "BYMONTH value ‘13’ is out of range"},
+		{"FREQ=YEARLY;BYWEEKNO=54", nil, "BYWEEKNO value ‘54’ is out of range"},
+		{"FREQ=MONTHLY;BYDAY=6MO", nil, "BYDAY value ‘6’ is out of range"},
+		{"FREQ=YEARLY;BYDAY=54MO", nil, "BYDAY value ‘54’ is out of range"},
+
+		// Constraints.
+		{"FREQ=DAILY;COUNT=5;UNTIL=20300101", nil, "COUNT and UNTIL cannot both be set"},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", nil, "BYMONTHDAY cannot be used with FREQ=WEEKLY"},
+		{"FREQ=MONTHLY;BYYEARDAY=1", nil, "BYYEARDAY cannot be used with FREQ=MONTHLY"},
+		{"FREQ=MONTHLY;BYWEEKNO=1", nil, "BYWEEKNO cannot be used with FREQ=MONTHLY"},
+		{"FREQ=WEEKLY;BYDAY=1MO", nil, "BYDAY cannot have a number with FREQ=WEEKLY"},
+		{"FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO", nil, "BYDAY cannot have a number with BYWEEKNO"},
+		{"FREQ=MONTHLY;BYSETPOS=1", nil, "BYSETPOS must be used with another BYxxx part"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRRule(tt.in)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasSuffix(err.Error(), ": "+tt.wantErr) {
+					t.Fatalf("wrong error\nout:  %v\nwant: %v\n", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRuleExpand(t *testing.T) {
+	ams, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		rule  string
+		start string
+		n     int
+		want  []string
+	}{
+		{"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=5", "2020-06-01 09:00", 10, []string{
+			"2020-06-01 09:00", "2020-06-03 09:00", "2020-06-08 09:00", "2020-06-10 09:00", "2020-06-15 09:00",
+		}},
+		{"FREQ=WEEKLY;BYDAY=MO,WE", "2020-06-01 09:00", 3, []string{
+			"2020-06-01 09:00", "2020-06-03 09:00", "2020-06-08 09:00",
+		}},
+		{"FREQ=WEEKLY;INTERVAL=2", "2020-06-03 09:00", 3, []string{
+			"2020-06-03 09:00", "2020-06-17 09:00", "2020-07-01 09:00",
+		}},
+		{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", "2020-01-01 12:00", 10, []string{
+			"2020-01-31 12:00", "2020-02-28 12:00", "2020-03-27 12:00",
+		}},
+		{"FREQ=MONTHLY", "2020-01-31 08:00", 3, []string{
+			"2020-01-31 08:00", "2020-03-31 08:00", "2020-05-31 08:00",
+		}},
+		{"FREQ=YEARLY", "2020-02-29 08:00", 2, []string{
+			"2020-02-29 08:00", "2024-02-29 08:00",
+		}},
+		{"FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU", "2020-01-01 02:30", 2, []string{
+			"2020-03-29 03:30", "2021-03-28 03:30", // DST gap.
+		}},
+		{"FREQ=DAILY", "2020-03-28 02:30", 3, []string{
+			"2020-03-28 02:30", "2020-03-29 03:30", "2020-03-30 02:30", // DST gap.
+		}},
+		{"FREQ=HOURLY", "2020-03-29 00:30", 3, []string{
+			"2020-03-29 00:30", "2020-03-29 01:30", "2020-03-29 03:30", // DST gap.
+		}},
+		{"FREQ=HOURLY", "2020-10-25 01:30", 3, []string{
+			"2020-10-25 01:30", "2020-10-25 02:30", "2020-10-25 03:30", // DST overlap.
+		}},
+		{"FREQ=DAILY;INTERVAL=2;UNTIL=20200607", "2020-06-01 10:00", 10, []string{
+			"2020-06-01 10:00", "2020-06-03 10:00", "2020-06-05 10:00", "2020-06-07 10:00",
+		}},
+		{"FREQ=DAILY;UNTIL=20200603T075959Z", "2020-06-01 10:00", 10, []string{
+			"2020-06-01 10:00", "2020-06-02 10:00",
+		}},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", "2020-01-01 17:00", 3, []string{
+			"2020-01-31 17:00", "2020-02-28 17:00", "2020-03-31 17:00",
+		}},
+		{"FREQ=HOURLY;INTERVAL=6;BYHOUR=0,12", "2020-06-01 00:00", 3, []string{
+			"2020-06-01 00:00", "2020-06-01 12:00", "2020-06-02 00:00",
+		}},
+		{"FREQ=MINUTELY;INTERVAL=20;BYHOUR=9", "2020-06-01 08:50", 4, []string{
+			"2020-06-01 09:10", "2020-06-01 09:30", "2020-06-01 09:50", "2020-06-02 09:10",
+		}},
+		{"FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30", "2020-06-01 12:00", 3, []string{
+			"2020-06-01 17:30", "2020-06-02 09:30", "2020-06-02 17:30",
+		}},
+		{"FREQ=YEARLY;BYWEEKNO=1;BYDAY=MO", "2024-06-01 09:00", 2, []string{
+			"2024-12-30 09:00", "2025-12-29 09:00",
+		}},
+		{"FREQ=YEARLY;BYYEARDAY=1,-1", "2020-06-01 09:00", 3, []string{
+			"2020-12-31 09:00", "2021-01-01 09:00", "2021-12-31 09:00",
+		}},
+		{"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", "2020-01-01 09:00", 3, nil},
+		{"FREQ=DAILY", "2020-06-01 09:00", 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			start, err := time.ParseInLocation("2006-01-02 15:04", tt.start, ams)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, o := range r.Expand(start, tt.n) {
+				if o.Location() != ams {
+					t.Errorf("wrong location: %s", o.Location())
+				}
+				got = append(got, o.Format("2006-01-02 15:04"))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRuleExpandLarge(t *testing.T) {
+	// Millions of times per year; these shouldn't all be generated.
+	r, err := ParseRRule("FREQ=YEARLY;BYHOUR=" + intList(0, 23) + ";BYMINUTE=" + intList(0, 59) +
+		";BYSECOND=" + intList(0, 59) + ";BYDAY=MO,TU,WE,TH,FR,SA,SU")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2020, 6, 1, 23, 59, 58, 0, time.UTC)
+
+	var got []time.Time
+	allocs := testing.AllocsPerRun(1, func() { got = r.Expand(start, 3) })
+	want := []time.Time{start, start.Add(time.Second), start.Add(2 * time.Second)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("\nout:  %#v\nwant: %#v\n", got, want)
+	}
+	if allocs > 1000 {
+		t.Errorf("too many allocations: %v", allocs)
+	}
+}
+
+func intList(from, to int) string {
+	var s []string
+	for i := from; i <= to; i++ {
+		s = append(s, strconv.Itoa(i))
+	}
+	return strings.Join(s, ",")
+}
+
+func TestWeekNumber(t *testing.T) {
+	tests := []struct {
+		in          string
+		weekStart   time.Weekday
+		week, weeks int
+	}{
+		{"2020-01-01", time.Monday, 1, 53},
+		{"2019-12-30", time.Monday, 1, 53},
+		{"2020-12-31", time.Monday, 53, 53},
+		{"2021-01-03", time.Monday, 53, 53},
+		{"2021-01-04", time.Monday, 1, 52},
+		{"2021-01-03", time.Sunday, 1, 52},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, _ := time.Parse("2006-01-02", tt.in)
+			week, weeks := weekNumber(d, tt.weekStart)
+			if week != tt.week || weeks != tt.weeks {
+				t.Errorf("\nout:  %d, %d\nwant: %d, %d\n", week, weeks, tt.week, tt.weeks)
+			}
+		})
+	}
+}
+
+func TestRRule(t *testing.T) {
+	start := time.Date(2020, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in      string
+		opts    RRuleOptions
+		wantErr []string
+	}{
+		{"", RRuleOptions{}, nil},
+		{"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", RRuleOptions{}, nil},
+		{"FREQ=WEEKLY;COUNT=5;UNTIL=20300101", RRuleOptions{},
+			[]string{fmt.Sprintf(MessageRRule, "COUNT and UNTIL cannot both be set")}},
+		{"FREQ=WEEKLY", RRuleOptions{MaxCount: 10}, []string{MessageRRuleEnd}},
+		{"FREQ=WEEKLY;COUNT=10", RRuleOptions{MaxCount: 10}, nil},
+		{"FREQ=WEEKLY;COUNT=11", RRuleOptions{MaxCount: 10}, []string{fmt.Sprintf(MessageRRuleCount, 10)}},
+		{"FREQ=WEEKLY;UNTIL=20200803", RRuleOptions{MaxCount: 10, Start: start}, nil},
+		{"FREQ=WEEKLY;UNTIL=20200810", RRuleOptions{MaxCount: 10, Start: start},
+			[]string{fmt.Sprintf(MessageRRuleCount, 10)}},
+		{"FREQ=YEARLY;UNTIL=20300101T000000Z;BYHOUR=" + intList(0, 23) + ";BYMINUTE=" + intList(0, 59) +
+			";BYSECOND=" + intList(0, 59), RRuleOptions{MaxCount: 10, Start: start},
+			[]string{fmt.Sprintf(MessageRRuleCount, 10)}},
+		{"FREQ=DAILY", RRuleOptions{MinFrequency: FreqDaily}, nil},
+		{"FREQ=HOURLY", RRuleOptions{MinFrequency: FreqDaily},
+			[]string{fmt.Sprintf(MessageRRuleFrequency, "daily")}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			r := v.RRule("rule", tt.in, tt.opts)
+			if !reflect.DeepEqual(v.Errors["rule"], tt.wantErr) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors["rule"], tt.wantErr)
+			}
+			if (r == nil) != (tt.in == "" || tt.wantErr != nil) {
+				t.Errorf("wrong return: %#v", r)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeZone validates that the string is an IANA time zone name, such as
+// "Europe/Amsterdam" or "UTC".
+//
+// The zone is loaded from the system's tz database. Import
+// github.com/teamwork/validate/tzdata to embed a copy as a fallback for systems
+// without one; this isn't done by default as it adds about 450KB to the
+// program. "Local" is rejected, as it depends on the server's configuration.
+//
+// The location is returned, or nil if the value is empty or invalid.
+func (v *Validator) TimeZone(key, value string, message ...string) *time.Location {
+	if value == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(value)
+	if err != nil || value == "Local" {
+		v.Append(key, getMessage(message, MessageTimeZone))
+		return nil
+	}
+	return loc
+}
+
+// TimeOfDay is a time without a date, such as "17:30".
+type TimeOfDay struct {
+	Hour, Minute int
+}
+
+// String formats the time as "15:04".
+func (t TimeOfDay) String() string { return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute) }
+
+// Minutes gets the number of minutes since midnight.
+func (t TimeOfDay) Minutes() int { return t.Hour*60 + t.Minute }
+
+var reTimeOfDay = regexp.MustCompile(`^(\d{1,2})(?:[:.](\d{2}))?\s*(?:([ap])\.?m\.?)?$`)
+
+// parseTimeOfDay parses "17:30", "5:30 pm", "5pm", or "5.30 p.m.".
+func parseTimeOfDay(value string) (TimeOfDay, bool) {
+	m := reTimeOfDay.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if m == nil {
+		return TimeOfDay{}, false
+	}
+
+	h, _ := strconv.Atoi(m[1])
+	min := 0
+	if m[2] != "" {
+		min, _ = strconv.Atoi(m[2])
+	}
+	if min > 59 {
+		return TimeOfDay{}, false
+	}
+
+	switch m[3] {
+	case "":
+		// A 24-hour time needs the minutes, as "9" could mean anything.
+		if m[2] == "" || h > 23 {
+			return TimeOfDay{}, false
+		}
+	default:
+		if h < 1 || h > 12 {
+			return TimeOfDay{}, false
+		}
+		h %= 12
+		if m[3] == "p" {
+			h += 12
+		}
+	}
+	return TimeOfDay{Hour: h, Minute: min}, true
+}
+
+// TimeOfDay validates that the string is a time of day in the 24-hour format
+// ("17:30") or 12-hour format ("5:30 pm", "5pm").
+//
+// The parsed time is returned.
+func (v *Validator) TimeOfDay(key, value string, message ...string) TimeOfDay {
+	if value == "" {
+		return TimeOfDay{}
+	}
+
+	t, ok := parseTimeOfDay(value)
+	if !ok {
+		v.Append(key, getMessage(message, MessageTimeOfDay))
+	}
+	return t
+}
+
+// TimeOfDayRangeOptions are the options for the TimeOfDayRange() validator.
+type TimeOfDayRangeOptions struct {
+	// Allow the end to be before the start, for ranges that span midnight such
+	// as "22:00" to "06:00".
+	AllowOvernight bool
+
+	// Minimum and maximum duration; the default of 0 means there is no limit.
+	MinDuration, MaxDuration time.Duration
+}
+
+// TimeOfDayRange validates that the start and end are valid times of day (see
+// TimeOfDay()), and that the end is after the start.
+//
+// Errors for parsing the times are added to their respective keys, and errors
+// about the range are added to endKey.
+//
+// The parsed times are returned.
+func (v *Validator) TimeOfDayRange(startKey, endKey, start, end string, opts TimeOfDayRangeOptions, message ...string) (TimeOfDay, TimeOfDay) {
+	s, sOK := parseTimeOfDay(start)
+	e, eOK := parseTimeOfDay(end)
+	if !sOK && start != "" {
+		v.Append(startKey, getMessage(message, MessageTimeOfDay))
+	}
+	if !eOK && end != "" {
+		v.Append(endKey, getMessage(message, MessageTimeOfDay))
+	}
+	if !sOK || !eOK {
+		return s, e
+	}
+
+	d := time.Duration(e.Minutes()-s.Minutes()) * time.Minute
+	if d < 0 && opts.AllowOvernight {
+		d += 24 * time.Hour
+	}
+
+	switch {
+	case d <= 0:
+		v.Append(endKey, getMessage(message, MessageTimeOfDayOrder))
+	case opts.MinDuration > 0 && d < opts.MinDuration:
+		v.Append(endKey, getMessage(message, fmt.Sprintf(MessageIntervalMin, formatDuration(opts.MinDuration))))
+	case opts.MaxDuration > 0 && d > opts.MaxDuration:
+		v.Append(endKey, getMessage(message, fmt.Sprintf(MessageIntervalMax, formatDuration(opts.MaxDuration))))
+	}
+	return s, e
+}
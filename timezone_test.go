@@ -0,0 +1,128 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	// Don't depend on the system's tz database; this is also used by the
+	// cron and rrule tests.
+	_ "github.com/teamwork/validate/tzdata"
+)
+
+func TestTimeZone(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr []string
+	}{
+		{"", "", nil},
+		{"UTC", "UTC", nil},
+		{"Europe/Amsterdam", "Europe/Amsterdam", nil},
+		{"America/Argentina/Buenos_Aires", "America/Argentina/Buenos_Aires", nil},
+		{"Local", "", []string{MessageTimeZone}},
+		{"Europe/Nowhere", "", []string{MessageTimeZone}},
+		{"../../etc/passwd", "", []string{MessageTimeZone}},
+		{"+02:00", "", []string{MessageTimeZone}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			loc := v.TimeZone("tz", tt.in)
+			got := ""
+			if loc != nil {
+				got = loc.String()
+			}
+			if got != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got, tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["tz"], tt.wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["tz"], tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"09:00", "09:00"},
+		{"9:00", "09:00"},
+		{"17:30", "17:30"},
+		{"00:00", "00:00"},
+		{"23:59", "23:59"},
+		{"17.30", "17:30"},
+		{" 17:30 ", "17:30"},
+		{"5pm", "17:00"},
+		{"5:30 PM", "17:30"},
+		{"5.30 p.m.", "17:30"},
+		{"12am", "00:00"},
+		{"12:15 am", "00:15"},
+		{"12pm", "12:00"},
+		{"11:59pm", "23:59"},
+
+		{"9", ""},
+		{"24:00", ""},
+		{"9:60", ""},
+		{"9:5", ""},
+		{"13pm", ""},
+		{"0am", ""},
+		{"noon", ""},
+		{"17:30:00", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			got := v.TimeOfDay("t", tt.in)
+
+			var wantErr []string
+			if tt.want == "" {
+				wantErr = []string{MessageTimeOfDay}
+			} else if got.String() != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", got.String(), tt.want)
+			}
+			if !reflect.DeepEqual(v.Errors["t"], wantErr) {
+				t.Errorf("errors\nout:  %#v\nwant: %#v\n", v.Errors["t"], wantErr)
+			}
+		})
+	}
+}
+
+func TestTimeOfDayRange(t *testing.T) {
+	tests := []struct {
+		start, end string
+		opts       TimeOfDayRangeOptions
+		wantErr    map[string][]string
+	}{
+		{"", "", TimeOfDayRangeOptions{}, map[string][]string{}},
+		{"09:00", "", TimeOfDayRangeOptions{}, map[string][]string{}},
+		{"09:00", "17:30", TimeOfDayRangeOptions{}, map[string][]string{}},
+		{"9am", "5:30pm", TimeOfDayRangeOptions{}, map[string][]string{}},
+		{"x", "y", TimeOfDayRangeOptions{}, map[string][]string{
+			"start": {MessageTimeOfDay}, "end": {MessageTimeOfDay},
+		}},
+		{"17:30", "09:00", TimeOfDayRangeOptions{}, map[string][]string{"end": {MessageTimeOfDayOrder}}},
+		{"09:00", "09:00", TimeOfDayRangeOptions{}, map[string][]string{"end": {MessageTimeOfDayOrder}}},
+		{"09:00", "09:00", TimeOfDayRangeOptions{AllowOvernight: true}, map[string][]string{"end": {MessageTimeOfDayOrder}}},
+		{"22:00", "06:00", TimeOfDayRangeOptions{AllowOvernight: true}, map[string][]string{}},
+		{"22:00", "06:00", TimeOfDayRangeOptions{AllowOvernight: true, MaxDuration: 4 * time.Hour},
+			map[string][]string{"end": {fmt.Sprintf(MessageIntervalMax, "4 hours")}}},
+		{"09:00", "09:15", TimeOfDayRangeOptions{MinDuration: 30 * time.Minute},
+			map[string][]string{"end": {fmt.Sprintf(MessageIntervalMin, "30 minutes")}}},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			v := New()
+			v.TimeOfDayRange("start", "end", tt.start, tt.end, tt.opts)
+			if !reflect.DeepEqual(v.Errors, tt.wantErr) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, tt.wantErr)
+			}
+		})
+	}
+}
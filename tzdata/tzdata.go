@@ -0,0 +1,12 @@
+// Package tzdata embeds a copy of the time zone database, as a fallback for
+// TimeZone() on systems without one.
+//
+// Import it for its side effect, preferably in the main package:
+//
+//	import _ "github.com/teamwork/validate/tzdata"
+//
+// This adds about 450KB to the program; it's the same as importing
+// "time/tzdata" or building with "-tags timetzdata".
+package tzdata
+
+import _ "time/tzdata" // Registers the embedded database with package time.
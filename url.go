@@ -0,0 +1,184 @@
+package validate
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// URLOptions are the options for the URLWithOptions() validator.
+type URLOptions struct {
+	// Allowed schemes; the default of nil allows all schemes.
+	//
+	// URLs without a host such as "mailto:me@example.com" are only accepted if
+	// the scheme is explicitly listed here.
+	Schemes []string
+
+	// Require that the URL has a scheme. If this is not set a URL without a
+	// scheme such as "example.com/path" gets DefaultScheme.
+	RequireScheme bool
+
+	// Scheme to use if the URL has none; the default is "http".
+	DefaultScheme string
+
+	// Allow IP addresses as the host, e.g. "http://127.0.0.1" or
+	// "http://[::1]".
+	AllowIP bool
+
+	// Allow hosts with a single label, e.g. "http://localhost" or
+	// "http://intranet".
+	AllowSingleLabel bool
+
+	// Allow relative references such as "/path?q=1", "../file", or "#top".
+	// Values without a scheme are always treated as a relative reference if
+	// this is set, rather than getting DefaultScheme.
+	AllowRelative bool
+
+	// Maximum length in characters; the default of 0 means there is no limit.
+	MaxLength int
+}
+
+// Default ports for some common schemes, which are removed when normalizing.
+var defaultSchemePorts = map[string]string{
+	"http": "80", "https": "443", "ws": "80", "wss": "443", "ftp": "21",
+}
+
+var reSingleLabel = regexp.MustCompile(`^[\p{L}\d-]{1,63}$`)
+
+// URLWithOptions validates that the string contains a valid URL, as configured
+// by the options.
+//
+// The URL is returned in normalized form: the scheme and host are lowercased,
+// internationalized domain names are converted to punycode, and default ports
+// (e.g. 443 for https) are removed.
+func (v *Validator) URLWithOptions(key, value string, opts URLOptions, message ...string) *url.URL {
+	if value == "" {
+		return nil
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) *url.URL {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+		return nil
+	}
+
+	if opts.MaxLength > 0 && utf8.RuneCountInString(value) > opts.MaxLength {
+		return appendMsg(fmt.Sprintf(MessageLenShorter, opts.MaxLength))
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return appendMsg(MessageURL)
+	}
+
+	hostPort := isHostPort(u, opts)
+	if u.Scheme == "" || hostPort {
+		switch {
+		case opts.AllowRelative && !hostPort:
+			if u.Host == "" {
+				return u
+			}
+		case opts.RequireScheme:
+			return appendMsg(MessageURL)
+		default:
+			scheme := opts.DefaultScheme
+			if scheme == "" {
+				scheme = "http"
+			}
+			u, err = url.Parse(scheme + "://" + strings.TrimPrefix(value, "//"))
+			if err != nil {
+				return appendMsg(MessageURL)
+			}
+		}
+	}
+
+	if u.Scheme != "" && opts.Schemes != nil && !includeString(opts.Schemes, u.Scheme) {
+		return appendMsg(fmt.Sprintf(MessageURLScheme, strings.Join(opts.Schemes, ", ")))
+	}
+
+	// Opaque URLs such as "mailto:" and "tel:".
+	if u.Host == "" {
+		if u.Opaque == "" || !includeString(opts.Schemes, u.Scheme) {
+			return appendMsg(MessageURL)
+		}
+		return u
+	}
+
+	host, ok := normalizeHost(u.Hostname(), opts)
+	if !ok {
+		return appendMsg(MessageURL)
+	}
+	port := u.Port()
+	if n, err := strconv.Atoi(port); port != "" && (err != nil || n < 1 || n > 65535) {
+		return appendMsg(MessageURL)
+	}
+	if port != "" && defaultSchemePorts[u.Scheme] == port {
+		port = ""
+	}
+	if port != "" {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	u.Host = host
+
+	return u
+}
+
+// isHostPort reports if the URL is a host and port without a scheme, such as
+// "example.com:8080" or "localhost:3000/x", which url.Parse() reads as the
+// scheme "example.com" or "localhost".
+//
+// Schemes listed in the options are always a scheme, so that "tel:5551234" is
+// not a host and port.
+func isHostPort(u *url.URL, opts URLOptions) bool {
+	if u.Scheme == "" || u.Opaque == "" || includeString(opts.Schemes, u.Scheme) {
+		return false
+	}
+	if strings.Contains(u.Scheme, ".") {
+		return true
+	}
+	port, _, _ := strings.Cut(u.Opaque, "/")
+	if port == "" {
+		return false
+	}
+	for i := 0; i < len(port); i++ {
+		if !isDigit(port[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeHost validates the host and converts it to lowercase punycode.
+//
+// Hosts such as "127.1" or "0x7f.1" are rejected, as these are IPv4 addresses
+// for most resolvers.
+func normalizeHost(host string, opts URLOptions) (string, bool) {
+	if ip := net.ParseIP(host); ip != nil {
+		return strings.ToLower(host), opts.AllowIP
+	}
+
+	host = strings.TrimSuffix(host, ".")
+	if looksNumeric(host) {
+		return "", false
+	}
+	if !validDomain(host) && !(opts.AllowSingleLabel && reSingleLabel.MatchString(host)) {
+		return "", false
+	}
+
+	ascii, err := idna.Lookup.ToASCII(host)
+	if err != nil {
+		return "", false
+	}
+	return ascii, true
+}
@@ -0,0 +1,86 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestURLWithOptions(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    URLOptions
+		want    string
+		wantErr string
+	}{
+		{"", URLOptions{}, "", ""},
+		{"example.com", URLOptions{}, "http://example.com", ""},
+		{"example.com/x?q=1#a", URLOptions{DefaultScheme: "https"}, "https://example.com/x?q=1#a", ""},
+		{"HTTPS://EXAMPLE.com:443/Path", URLOptions{}, "https://example.com/Path", ""},
+		{"http://example.com:80", URLOptions{}, "http://example.com", ""},
+		{"http://example.com:8080", URLOptions{}, "http://example.com:8080", ""},
+		{"https://example.com.", URLOptions{}, "https://example.com", ""},
+		{"https://Bücher.example/x", URLOptions{}, "https://xn--bcher-kva.example/x", ""},
+		{"https://xn--bcher-kva.example", URLOptions{}, "https://xn--bcher-kva.example", ""},
+		{"http://127.0.0.1:8080/x", URLOptions{AllowIP: true}, "http://127.0.0.1:8080/x", ""},
+		{"http://[::1]/x", URLOptions{AllowIP: true}, "http://[::1]/x", ""},
+		{"http://[2001:DB8::1]:80/x", URLOptions{AllowIP: true}, "http://[2001:db8::1]/x", ""},
+		{"http://localhost:3000", URLOptions{AllowSingleLabel: true}, "http://localhost:3000", ""},
+		{"example.com:8080", URLOptions{}, "http://example.com:8080", ""},
+		{"example.com:443/x", URLOptions{DefaultScheme: "https"}, "https://example.com/x", ""},
+		{"localhost:3000/x", URLOptions{AllowSingleLabel: true}, "http://localhost:3000/x", ""},
+		{"example.com:8080", URLOptions{AllowRelative: true}, "http://example.com:8080", ""},
+		{"tel:5551234", URLOptions{Schemes: []string{"tel"}}, "tel:5551234", ""},
+		{"/path?q=1", URLOptions{AllowRelative: true}, "/path?q=1", ""},
+		{"../file", URLOptions{AllowRelative: true}, "../file", ""},
+		{"#top", URLOptions{AllowRelative: true}, "#top", ""},
+		{"//Example.com/x", URLOptions{AllowRelative: true}, "//example.com/x", ""},
+		{"mailto:me@example.com", URLOptions{Schemes: []string{"mailto", "https"}}, "mailto:me@example.com", ""},
+		{"https://example.com", URLOptions{Schemes: []string{"mailto", "https"}}, "https://example.com", ""},
+
+		{"example.com", URLOptions{RequireScheme: true}, "", "must be a valid url"},
+		{"ftp://example.com", URLOptions{Schemes: []string{"http", "https"}}, "",
+			"must use one of the schemes ‘http, https’"},
+		{"mailto:me@example.com", URLOptions{}, "", "must be a valid url"},
+		{"http://127.0.0.1", URLOptions{}, "", "must be a valid url"},
+		{"http://[::1]", URLOptions{}, "", "must be a valid url"},
+		{"http://127.1/", URLOptions{}, "", "must be a valid url"},
+		{"http://0x7f.1/", URLOptions{}, "", "must be a valid url"},
+		{"http://127.1/", URLOptions{AllowIP: true}, "", "must be a valid url"},
+		{"http://2130706433/", URLOptions{AllowSingleLabel: true}, "", "must be a valid url"},
+		{"http://1.example.com/", URLOptions{}, "http://1.example.com/", ""},
+		{"http://localhost", URLOptions{}, "", "must be a valid url"},
+		{"localhost:3000", URLOptions{}, "", "must be a valid url"},
+		{"example.com:8080", URLOptions{RequireScheme: true}, "", "must be a valid url"},
+		{"http://example.com:0", URLOptions{}, "", "must be a valid url"},
+		{"http://example.com:65536", URLOptions{}, "", "must be a valid url"},
+		{"http://example.com:65535", URLOptions{}, "http://example.com:65535", ""},
+		{"http://exa mple.com", URLOptions{}, "", "must be a valid url"},
+		{"http://ex_ample.com", URLOptions{}, "", "must be a valid url"},
+		{"/path", URLOptions{}, "", "must be a valid url"},
+		{"%zz", URLOptions{AllowRelative: true}, "", "must be a valid url"},
+		{"https://example.com/long", URLOptions{MaxLength: 20}, "", "must be shorter than 20 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			v := New()
+			u := v.URLWithOptions("k", tt.in, tt.opts)
+
+			want := map[string][]string{}
+			if tt.wantErr != "" {
+				want["k"] = []string{tt.wantErr}
+			}
+			if !reflect.DeepEqual(v.Errors, want) {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", v.Errors, want)
+			}
+
+			out := ""
+			if u != nil {
+				out = u.String()
+			}
+			if out != tt.want {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.want)
+			}
+		})
+	}
+}
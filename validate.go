@@ -60,15 +60,24 @@ import (
 
 // Validator hold the validation errors.
 //
-// Typically you shouldn't create this directly but use the New() function.
+// Typically you shouldn't create this directly but use the New() function. If
+// you do, use a keyed literal such as Validator{Errors: errs}, as there are
+// unexported fields.
 type Validator struct {
 	Errors map[string][]string `json:"errors"`
+
+	// Structured paths for keys added by Sub() or AppendPath().
+	paths map[string]Path
+
+	// Key mapping for output, set with MapKeys().
+	keyMap *KeyMap
 }
 
 // New makes a new Validator and ensures that it is properly initialized.
 func New() Validator {
 	v := Validator{}
 	v.Errors = make(map[string][]string)
+	v.paths = make(map[string]Path)
 	return v
 }
 
@@ -79,14 +88,53 @@ func (v Validator) Error() string { return v.String() }
 // interface in github.com/teamwork/guru.
 func (v Validator) Code() int { return 400 }
 
-// ErrorJSON for reporting errors as JSON.
-func (v Validator) ErrorJSON() ([]byte, error) { return json.Marshal(v) }
+// ErrorJSON for reporting errors as JSON, with the KeyMap applied.
+//
+// This isn't a MarshalJSON() method, as that would be promoted to structs that
+// embed a Validator and replace their JSON encoding.
+func (v Validator) ErrorJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors map[string][]string `json:"errors"`
+	}{v.MappedErrors()})
+}
 
 // Append a new error to the error list for this key.
 func (v *Validator) Append(key, message string) {
 	v.Errors[key] = append(v.Errors[key], message)
 }
 
+// AppendPath adds a new error for the key described by the path.
+//
+// The error is stored under p.String(), and Path() will return p for this key.
+func (v *Validator) AppendPath(p Path, message string) {
+	key := p.String()
+	v.setPath(key, p)
+	v.Append(key, message)
+}
+
+// Path gets the structured path for a key.
+//
+// This is the path that was used to create the key in Sub() or AppendPath().
+// Keys added with Append() are parsed with ParsePath(), and keys which can't be
+// parsed are returned as a single field.
+func (v *Validator) Path(key string) Path {
+	if p, ok := v.paths[key]; ok {
+		return p.Append()
+	}
+	p, err := ParsePath(key)
+	if err != nil {
+		return Path{PathField(key)}
+	}
+	return p
+}
+
+func (v *Validator) setPath(key string, p Path) {
+	if v.paths == nil {
+		v.paths = make(map[string]Path)
+	}
+	v.paths[key] = p
+}
+
 // HasErrors reports if this validation has any errors.
 func (v *Validator) HasErrors() bool {
 	return len(v.Errors) > 0
@@ -136,14 +184,17 @@ func (v *Validator) Sub(key, subKey string, err error) {
 		return
 	}
 
+	path := Path{PathField(key)}
 	if subKey != "" {
 		key = fmt.Sprintf("%s[%s]", key, subKey)
+		path = path.Append(PathIndex(subKey))
 	}
 
 	sub, ok := err.(*Validator)
 	if !ok {
 		ss, ok := err.(Validator)
 		if !ok {
+			v.setPath(key, path)
 			v.Append(key, err.Error())
 			return
 		}
@@ -155,6 +206,7 @@ func (v *Validator) Sub(key, subKey string, err error) {
 
 	for k, val := range sub.Errors {
 		mk := fmt.Sprintf("%s.%s", key, k)
+		v.setPath(mk, path.Append(sub.Path(k)...))
 		v.Errors[mk] = append(v.Errors[mk], val...)
 	}
 }
@@ -162,6 +214,9 @@ func (v *Validator) Sub(key, subKey string, err error) {
 // Merge errors from another validator in to this one.
 func (v *Validator) Merge(other Validator) {
 	for k, val := range other.Errors {
+		if p, ok := other.paths[k]; ok {
+			v.setPath(k, p)
+		}
 		v.Errors[k] = append(v.Errors[k], val...)
 	}
 }
@@ -173,10 +228,12 @@ func (v *Validator) String() string {
 		return "<no errors>"
 	}
 
+	errs := v.MappedErrors()
+
 	// Make sure the order is always the same.
-	keys := make([]string, len(v.Errors))
+	keys := make([]string, len(errs))
 	i := 0
-	for k := range v.Errors {
+	for k := range errs {
 		keys[i] = k
 		i++
 	}
@@ -184,7 +241,7 @@ func (v *Validator) String() string {
 
 	var b strings.Builder
 	for _, k := range keys {
-		s := fmt.Sprintf("%s: %s.\n", k, strings.Join(v.Errors[k], ", "))
+		s := fmt.Sprintf("%s: %s.\n", k, strings.Join(errs[k], ", "))
 		b.WriteString(s)
 
 	}
@@ -414,7 +471,8 @@ func (v *Validator) IncludeWithSanitization(
 // characters or as punycode.
 //
 // Limitation: the RFC limits domain labels to 63 bytes, but this validation
-// accepts labels up to 63 *characters*.
+// accepts labels up to 63 *characters*. Use StrictDomain() for a validation
+// which follows the RFCs more closely.
 func (v *Validator) Domain(key, value string, message ...string) {
 	if value == "" {
 		return
@@ -568,6 +626,9 @@ func (v *Validator) Len(key, value string, min, max int, message ...string) {
 }
 
 // Integer checks if this looks like an integer (i.e. a whole number).
+//
+// Use IntegerWithOptions() or UnsignedInteger() for other integer sizes,
+// bounds, and formats.
 func (v *Validator) Integer(key, value string, message ...string) int64 {
 	if value == "" {
 		return 0
@@ -580,23 +641,18 @@ func (v *Validator) Integer(key, value string, message ...string) int64 {
 	return i
 }
 
-// Boolean checks if this looks like a boolean value.
+// Boolean checks if this looks like a boolean value: one of
+// DefaultTrueValues or DefaultFalseValues.
+//
+// Use BooleanWithOptions() or OptionalBoolean() for other values, or to tell
+// empty values apart from false.
 func (v *Validator) Boolean(key, value string, message ...string) bool {
-	if value == "" {
-		return false
-	}
-
-	switch strings.ToLower(value) {
-	case "1", "y", "yes", "t", "true":
-		return true
-	case "0", "n", "no", "f", "false":
-		return false
-	}
-	v.Append(key, getMessage(message, MessageBool))
-	return false
+	return v.BooleanWithOptions(key, value, BooleanOptions{}, message...)
 }
 
 // Date checks if the string looks like a date in the given layout.
+//
+// Use DateTime() to get the parsed time, or to accept multiple layouts.
 func (v *Validator) Date(key, value, layout string, message ...string) {
 	msg := getMessage(message, "")
 	_, err := time.Parse(layout, value)
@@ -617,7 +673,8 @@ var rePhone = regexp.MustCompile(`^[0123456789+\-() .]{5,20}$`)
 // https://en.wikipedia.org/wiki/National_conventions_for_writing_telephone_numbers
 //
 // This merely checks a field contains 5 to 20 characters "0123456789+\-() .",
-// which is not very strict but should cover all conventions.
+// which is not very strict but should cover all conventions. Use
+// PhoneWithOptions() to parse and validate the number.
 func (v *Validator) Phone(key, value string, message ...string) {
 	if value == "" {
 		return
@@ -652,6 +709,10 @@ func (v *Validator) Range(key string, value, min, max int64, message ...string)
 }
 
 // Equal returns whether two validators are equal.
+//
+// The order of the messages doesn't matter, but duplicate messages are
+// counted: {"a", "a"} and {"a", "b"} are different. A nil Validator is the
+// same as one without errors.
 func (v *Validator) Equal(o *Validator) bool {
 	if v == nil && o == nil {
 		return true
@@ -675,17 +736,7 @@ func (v *Validator) Equal(o *Validator) bool {
 			return false
 		}
 
-		if len(vmsgs) != len(omsgs) {
-			return false
-		}
-
-	NEXT:
-		for _, vmsg := range vmsgs {
-			for _, omsg := range omsgs {
-				if vmsg == omsg {
-					continue NEXT
-				}
-			}
+		if len(vmsgs) != len(omsgs) || len(subtractMessages(vmsgs, omsgs)) > 0 {
 			return false
 		}
 	}
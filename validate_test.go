@@ -229,22 +229,22 @@ func TestString(t *testing.T) {
 		hasErrors string
 	}{
 		{Validator{}, "<no errors>"},
-		{Validator{map[string][]string{}}, "<no errors>"},
+		{Validator{Errors: map[string][]string{}}, "<no errors>"},
 
-		{Validator{map[string][]string{
+		{Validator{Errors: map[string][]string{
 			"k": {"oh no"},
 		}}, "k: oh no.\n"},
-		{Validator{map[string][]string{
+		{Validator{Errors: map[string][]string{
 			"k": {"oh no", "more"},
 		}}, "k: oh no, more.\n"},
-		{Validator{map[string][]string{
+		{Validator{Errors: map[string][]string{
 			"k": {"oh no", "more", "even more"},
 		}}, "k: oh no, more, even more.\n"},
-		{Validator{map[string][]string{
+		{Validator{Errors: map[string][]string{
 			"k":  {"oh no", "more", "even more"},
 			"k2": {"asd"},
 		}}, "k: oh no, more, even more.\nk2: asd.\n"},
-		{Validator{map[string][]string{
+		{Validator{Errors: map[string][]string{
 			"zxc": {"asd"},
 			"asd": {"oh no", "more", "even more"},
 		}}, "asd: oh no, more, even more.\nzxc: asd.\n"},
@@ -1070,6 +1070,18 @@ func TestEqual(t *testing.T) {
 			false,
 		},
 
+		// duplicate messages
+		{
+			&Validator{Errors: map[string][]string{"a": {"A", "A"}}},
+			&Validator{Errors: map[string][]string{"a": {"A", "B"}}},
+			false,
+		},
+		{
+			&Validator{Errors: map[string][]string{"a": {"A", "B", "A"}}},
+			&Validator{Errors: map[string][]string{"a": {"A", "A", "B"}}},
+			true,
+		},
+
 		// missing keys
 		{
 			&Validator{Errors: map[string][]string{"a": {"A"}}},
@@ -1098,6 +1110,17 @@ func TestEqual(t *testing.T) {
 			if actual := tt.in.Equal(tt.vs); actual != tt.equal {
 				t.Errorf("\nout:  %#v\nwant: %#v\n", actual, tt.equal)
 			}
+			if empty := tt.in.Diff(tt.vs).Empty(); empty != tt.equal {
+				t.Errorf("Diff().Empty()\nout:  %#v\nwant: %#v\n", empty, tt.equal)
+			}
 		})
 	}
 }
+
+// errorContains reports if err contains want, or if both are empty.
+func errorContains(err error, want string) bool {
+	if err == nil || want == "" {
+		return err == nil && want == ""
+	}
+	return strings.Contains(err.Error(), want)
+}
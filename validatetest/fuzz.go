@@ -0,0 +1,179 @@
+package validatetest
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// SeedEnv is the environment variable to set the seed for NoPanic(), to replay
+// a failure, e.g.:
+//
+//	VALIDATETEST_SEED=1600000000000000000 go test -run TestValidate ./...
+const SeedEnv = "VALIDATETEST_SEED"
+
+// NoPanic calls fn n times with random arguments and fails the test if it
+// panics.
+//
+// This is useful to ensure that a Validate() method can't panic on arbitrary
+// input:
+//
+//	validatetest.NoPanic(t, func(c Customer) error { return c.Validate() }, 1000)
+//
+// The arguments are generated from the parameter types of fn. Exported struct
+// fields, slices, maps, pointers, and basic types are filled with random values
+// with a bias towards edge cases such as empty strings, invalid UTF-8, and the
+// minimum and maximum integer values. Unexported fields, interfaces, funcs,
+// and channels are left as the zero value.
+//
+// The random seed is reported on failure, and can be set with the environment
+// variable in SeedEnv to generate the same arguments again.
+//
+// It will panic if fn is not a function.
+func NoPanic(t testing.TB, fn interface{}, n int) {
+	t.Helper()
+
+	f := reflect.ValueOf(fn)
+	if f.Kind() != reflect.Func {
+		panic(fmt.Sprintf("validatetest.NoPanic: not a function: %T", fn))
+	}
+
+	seed := time.Now().UnixNano()
+	if env := os.Getenv(SeedEnv); env != "" {
+		var err error
+		seed, err = strconv.ParseInt(env, 10, 64)
+		if err != nil {
+			t.Fatalf("invalid %s: %v", SeedEnv, err)
+			return
+		}
+	}
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < n; i++ {
+		args := make([]reflect.Value, f.Type().NumIn())
+		for j := range args {
+			args[j] = randomValue(r, f.Type().In(j), 0)
+		}
+
+		if p := callNoPanic(f, args); p != nil {
+			in := make([]string, len(args))
+			for j, a := range args {
+				in[j] = fmt.Sprintf("%#v", a.Interface())
+			}
+			t.Errorf("panic with seed %[1]d (set %[2]s=%[1]d to replay) for input (%[3]s): %[4]v",
+				seed, SeedEnv, strings.Join(in, ", "), p)
+			return
+		}
+	}
+}
+
+func callNoPanic(f reflect.Value, args []reflect.Value) (p interface{}) {
+	defer func() { p = recover() }()
+	// The last argument of a variadic function is generated as a slice.
+	if f.Type().IsVariadic() {
+		f.CallSlice(args)
+	} else {
+		f.Call(args)
+	}
+	return nil
+}
+
+// Strings that tend to find edge cases.
+var edgeStrings = []string{
+	"", " ", "\t\n", "0", "-1", "1e3", "true", "null", "NaN", "-Inf",
+	"a@b", "@", "http://", "::1", "127.0.0.1", "[", "]", ".", "..", "a.b[0]",
+	"\x00", "\xff\xfe", "é", "ราคา", "😀", "‮", strings.Repeat("a", 1000),
+}
+
+// Rune ranges for random strings: ASCII, Latin-1, Cyrillic, CJK, emoji, and
+// control characters.
+var runeRanges = [][2]rune{
+	{0x20, 0x7e}, {0x20, 0x7e}, {0xa0, 0xff}, {0x400, 0x4ff},
+	{0x4e00, 0x4fff}, {0x1f600, 0x1f64f}, {0x00, 0x1f},
+}
+
+const maxDepth = 4
+
+func randomValue(r *rand.Rand, typ reflect.Type, depth int) reflect.Value {
+	v := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		v.SetString(randomString(r))
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch r.Intn(4) {
+		case 0:
+			v.SetInt(int64(r.Intn(3)) - 1)
+		case 1:
+			v.SetInt(-1 << (uint(typ.Bits()) - 1))
+		case 2:
+			v.SetInt(1<<(uint(typ.Bits())-1) - 1)
+		default:
+			v.SetInt(int64(r.Uint64() >> (64 - uint(typ.Bits()))))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		switch r.Intn(3) {
+		case 0:
+			v.SetUint(uint64(r.Intn(2)))
+		case 1:
+			v.SetUint(1<<uint(typ.Bits()) - 1)
+		default:
+			v.SetUint(r.Uint64() >> (64 - uint(typ.Bits())))
+		}
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(r.NormFloat64() * 1e6)
+	case reflect.Ptr:
+		if depth < maxDepth && r.Intn(4) > 0 {
+			p := reflect.New(typ.Elem())
+			p.Elem().Set(randomValue(r, typ.Elem(), depth+1))
+			v.Set(p)
+		}
+	case reflect.Slice:
+		if depth < maxDepth {
+			n := r.Intn(4)
+			s := reflect.MakeSlice(typ, n, n)
+			for i := 0; i < n; i++ {
+				s.Index(i).Set(randomValue(r, typ.Elem(), depth+1))
+			}
+			v.Set(s)
+		}
+	case reflect.Array:
+		for i := 0; i < typ.Len(); i++ {
+			v.Index(i).Set(randomValue(r, typ.Elem(), depth+1))
+		}
+	case reflect.Map:
+		if depth < maxDepth {
+			m := reflect.MakeMap(typ)
+			for i := r.Intn(4); i > 0; i-- {
+				m.SetMapIndex(randomValue(r, typ.Key(), depth+1), randomValue(r, typ.Elem(), depth+1))
+			}
+			v.Set(m)
+		}
+	case reflect.Struct:
+		for i := 0; i < typ.NumField(); i++ {
+			if typ.Field(i).PkgPath != "" {
+				continue
+			}
+			v.Field(i).Set(randomValue(r, typ.Field(i).Type, depth+1))
+		}
+	}
+	return v
+}
+
+func randomString(r *rand.Rand) string {
+	if r.Intn(3) == 0 {
+		return edgeStrings[r.Intn(len(edgeStrings))]
+	}
+
+	var b strings.Builder
+	for i := r.Intn(20); i > 0; i-- {
+		rr := runeRanges[r.Intn(len(runeRanges))]
+		b.WriteRune(rr[0] + rune(r.Intn(int(rr[1]-rr[0]+1))))
+	}
+	return b.String()
+}
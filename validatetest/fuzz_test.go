@@ -0,0 +1,62 @@
+package validatetest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/teamwork/validate"
+)
+
+func TestNoPanic(t *testing.T) {
+	NoPanic(t, func(c customer) error {
+		if c.internal != 0 {
+			panic("unexported field was set")
+		}
+		return c.Validate()
+	}, 1000)
+	NoPanic(t, func(s string, i int8, u uint64, p *[]map[string]*customer, a [2]bool) {}, 1000)
+	NoPanic(t, func(key string, message ...string) {}, 1000)
+	NoPanic(t, func(v validate.Validator, key, value string, message ...string) {
+		if len(message) > 1 {
+			message = message[:1]
+		}
+		v.Email(key, value, message...)
+	}, 1000)
+
+	ft := &fakeT{}
+	NoPanic(ft, func(c customer) {
+		if len(c.Addresses) > 0 && c.Addresses[0] != nil {
+			panic("oh noes")
+		}
+	}, 1000)
+	if out := ft.failed(); !strings.Contains(out, "oh noes") || !strings.Contains(out, "validatetest.customer{") {
+		t.Errorf("wrong output: %q", out)
+	}
+}
+
+func TestNoPanicSeed(t *testing.T) {
+	t.Setenv(SeedEnv, "42")
+
+	var runs [2][]string
+	for i := range runs {
+		NoPanic(t, func(s string, n int) { runs[i] = append(runs[i], fmt.Sprintf("%q %d", s, n)) }, 100)
+	}
+	if !reflect.DeepEqual(runs[0], runs[1]) {
+		t.Errorf("different arguments with the same seed\nfirst:  %v\nsecond: %v", runs[0], runs[1])
+	}
+
+	ft := &fakeT{}
+	NoPanic(ft, func(s string) { panic("oh noes") }, 1)
+	if out := ft.failed(); !strings.Contains(out, "panic with seed 42 (set VALIDATETEST_SEED=42 to replay)") {
+		t.Errorf("wrong output: %q", out)
+	}
+
+	t.Setenv(SeedEnv, "x")
+	ft = &fakeT{}
+	NoPanic(ft, func(s string) {}, 1)
+	if out := ft.failed(); !strings.Contains(out, "invalid VALIDATETEST_SEED") {
+		t.Errorf("wrong output: %q", out)
+	}
+}
@@ -0,0 +1,102 @@
+package validatetest
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Case is a single test case for Run().
+type Case struct {
+	// Name of the test; the index in the table is used if this is empty.
+	Name string
+
+	// Validate runs the validation; this is typically a Validate() method
+	// value such as Customer{Name: ""}.Validate.
+	Validate func() error
+
+	// Want are the errors the validation should return; the order of the
+	// messages doesn't matter.
+	Want map[string][]string
+}
+
+// Run all test cases as subtests, checking the errors with AssertErrors().
+//
+// For example:
+//
+//	validatetest.Run(t, []validatetest.Case{
+//	    {"ok", Customer{Name: "x"}.Validate, nil},
+//	    {"no name", Customer{}.Validate, map[string][]string{
+//	        "name": {"must be set"},
+//	    }},
+//	})
+func Run(t *testing.T, cases []Case) {
+	t.Helper()
+
+	for i, tt := range cases {
+		tt := tt
+		name := tt.Name
+		if name == "" {
+			name = fmt.Sprintf("%v", i)
+		}
+		t.Run(name, func(t *testing.T) {
+			t.Helper()
+			AssertErrors(t, tt.Validate(), tt.Want)
+		})
+	}
+}
+
+// AssertHas checks that the error is a Validator with the message for the key.
+//
+// If message is empty it checks that there is at least one error for the key.
+func AssertHas(t testing.TB, err error, key, message string) {
+	t.Helper()
+
+	v, ok := Validator(err)
+	if !ok {
+		t.Errorf("not a validate.Validator but %T: %v", err, err)
+		return
+	}
+
+	if message == "" {
+		if !v.Has(key) {
+			t.Errorf("no errors for key %q; errors:\n%s", key, v)
+		}
+		return
+	}
+	if !hasMessage(v.Get(key), message) {
+		t.Errorf("no error %q for key %q; errors:\n%s", message, key, v)
+	}
+}
+
+// AssertNotHas checks that the error is not a Validator with the message for
+// the key. A nil error is never reported.
+//
+// If message is empty it checks that there are no errors at all for the key.
+func AssertNotHas(t testing.TB, err error, key, message string) {
+	t.Helper()
+
+	v, ok := Validator(err)
+	if !ok {
+		t.Errorf("not a validate.Validator but %T: %v", err, err)
+		return
+	}
+
+	if message == "" {
+		if v.Has(key) {
+			t.Errorf("unexpected errors for key %q: %q", key, v.Get(key))
+		}
+		return
+	}
+	if hasMessage(v.Get(key), message) {
+		t.Errorf("unexpected error %q for key %q", message, key)
+	}
+}
+
+func hasMessage(msgs []string, message string) bool {
+	for _, m := range msgs {
+		if m == message {
+			return true
+		}
+	}
+	return false
+}
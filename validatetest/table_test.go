@@ -0,0 +1,85 @@
+package validatetest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/teamwork/validate"
+)
+
+type customer struct {
+	Name      string
+	Email     string
+	Age       int64
+	Addresses []*address
+	Tags      map[string]string
+	internal  int
+}
+
+type address struct {
+	City string
+}
+
+func (c customer) Validate() error {
+	v := validate.New()
+	v.Required("name", c.Name)
+	v.Email("email", c.Email)
+	v.Range("age", c.Age, 0, 150)
+	for i, a := range c.Addresses {
+		if a == nil {
+			continue
+		}
+		s := validate.New()
+		s.Required("city", a.City)
+		v.Sub("addresses", fmt.Sprintf("%d", i), s.ErrorOrNil())
+	}
+	return v.ErrorOrNil()
+}
+
+func TestRun(t *testing.T) {
+	Run(t, []Case{
+		{"ok", customer{Name: "x"}.Validate, nil},
+		{"", customer{Email: "x"}.Validate, map[string][]string{
+			"name":  {"must be set"},
+			"email": {"must be a valid email address"},
+		}},
+		{"address", customer{Name: "x", Addresses: []*address{{"Bristol"}, {}}}.Validate, map[string][]string{
+			"addresses[1].city": {"must be set"},
+		}},
+	})
+}
+
+func TestAssertHas(t *testing.T) {
+	err := customer{Email: "x"}.Validate()
+
+	tests := []struct {
+		in           error
+		key, message string
+		has, notHas  string
+	}{
+		{err, "name", "", "", `unexpected errors for key "name": ["must be set"]`},
+		{err, "name", "must be set", "", `unexpected error "must be set" for key "name"`},
+		{err, "name", "other", `no error "other" for key "name"`, ""},
+		{err, "age", "", `no errors for key "age"`, ""},
+		{nil, "age", "", `no errors for key "age"`, ""},
+		{errors.New("x"), "age", "", "not a validate.Validator", "not a validate.Validator"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			ft := &fakeT{}
+			AssertHas(ft, tt.in, tt.key, tt.message)
+			if out := ft.failed(); !strings.HasPrefix(out, tt.has) || (tt.has == "") != (out == "") {
+				t.Errorf("AssertHas\nout:  %#v\nwant: %#v\n", out, tt.has)
+			}
+
+			ft = &fakeT{}
+			AssertNotHas(ft, tt.in, tt.key, tt.message)
+			if out := ft.failed(); !strings.HasPrefix(out, tt.notHas) || (tt.notHas == "") != (out == "") {
+				t.Errorf("AssertNotHas\nout:  %#v\nwant: %#v\n", out, tt.notHas)
+			}
+		})
+	}
+}
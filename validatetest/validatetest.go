@@ -0,0 +1,103 @@
+// Package validatetest provides helpers for testing validations.
+//
+// Basic usage example:
+//
+//	func TestValidate(t *testing.T) {
+//	    c := Customer{Name: ""}
+//	    validatetest.AssertErrors(t, c.Validate(), map[string][]string{
+//	        "name": {"must be set"},
+//	    })
+//	}
+package validatetest // import "github.com/teamwork/validate/validatetest"
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/teamwork/validate"
+)
+
+// UpdateEnv is the environment variable to set to update golden files with
+// AssertGolden(), e.g.:
+//
+//	VALIDATETEST_UPDATE=1 go test ./...
+const UpdateEnv = "VALIDATETEST_UPDATE"
+
+// Validator gets the Validator from an error.
+//
+// A nil error returns an empty Validator. It returns false if the error is not
+// a validate.Validator or *validate.Validator.
+func Validator(err error) (*validate.Validator, bool) {
+	switch v := err.(type) {
+	case nil:
+		n := validate.New()
+		return &n, true
+	case *validate.Validator:
+		if v == nil {
+			n := validate.New()
+			return &n, true
+		}
+		return v, true
+	case validate.Validator:
+		return &v, true
+	default:
+		return nil, false
+	}
+}
+
+// AssertErrors checks that the error is a Validator with exactly the errors in
+// want. The order of the messages doesn't matter.
+//
+// A nil error is the same as a Validator without errors.
+func AssertErrors(t testing.TB, err error, want map[string][]string) {
+	t.Helper()
+
+	v, ok := Validator(err)
+	if !ok {
+		t.Errorf("not a validate.Validator but %T: %v", err, err)
+		return
+	}
+
+	if d := v.Diff(&validate.Validator{Errors: want}); !d.Empty() {
+		t.Errorf("wrong validation errors:\n%s", d)
+	}
+}
+
+// AssertGolden checks that the String() representation of the Validator in
+// err matches the contents of the file.
+//
+// The file is written instead if the environment variable in UpdateEnv is set,
+// creating directories as needed.
+func AssertGolden(t testing.TB, err error, file string) {
+	t.Helper()
+
+	v, ok := Validator(err)
+	if !ok {
+		t.Errorf("not a validate.Validator but %T: %v", err, err)
+		return
+	}
+	out := []byte(v.String())
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatalf("updating golden file: %v", err)
+			return
+		}
+		if err := os.WriteFile(file, out, 0o644); err != nil {
+			t.Fatalf("updating golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("reading golden file (set %s=1 to create it): %v", UpdateEnv, err)
+		return
+	}
+	if !bytes.Equal(out, want) {
+		t.Errorf("validation errors don't match golden file %q\nout:\n%s\nwant:\n%s",
+			file, out, want)
+	}
+}
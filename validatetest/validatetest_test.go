@@ -0,0 +1,103 @@
+package validatetest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/teamwork/validate"
+)
+
+// fakeT records failures instead of failing the test.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.Errorf(format, args...)
+}
+
+func (t *fakeT) failed() string { return strings.Join(t.errors, "\n") }
+
+func TestAssertErrors(t *testing.T) {
+	v := validate.New()
+	v.Required("name", "")
+	v.Append("name", "oh noes")
+
+	tests := []struct {
+		in      error
+		want    map[string][]string
+		wantErr string
+	}{
+		{nil, nil, ""},
+		{nil, map[string][]string{}, ""},
+		{(*validate.Validator)(nil), nil, ""},
+		{&v, map[string][]string{"name": {"oh noes", "must be set"}}, ""},
+		{v, map[string][]string{"name": {"oh noes", "must be set"}}, ""},
+		{v, map[string][]string{"name": {"must be set"}},
+			"wrong validation errors:\nkey \"name\":\n    extra:   \"oh noes\"\n"},
+		{nil, map[string][]string{"name": {"must be set"}},
+			"wrong validation errors:\nmissing key \"name\": \"must be set\"\n"},
+		{errors.New("x"), nil, "not a validate.Validator but *errors.errorString: x"},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%v", i), func(t *testing.T) {
+			ft := &fakeT{}
+			AssertErrors(ft, tt.in, tt.want)
+			if out := ft.failed(); out != tt.wantErr {
+				t.Errorf("\nout:  %#v\nwant: %#v\n", out, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "sub", "errors.golden")
+	v := validate.New()
+	v.Required("name", "")
+
+	ft := &fakeT{}
+	AssertGolden(ft, v, file)
+	if !strings.Contains(ft.failed(), "reading golden file") {
+		t.Errorf("no error for missing file: %q", ft.failed())
+	}
+
+	os.Setenv(UpdateEnv, "1")
+	ft = &fakeT{}
+	AssertGolden(ft, v, file)
+	os.Unsetenv(UpdateEnv)
+	if ft.failed() != "" {
+		t.Fatal(ft.failed())
+	}
+	got, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "name: must be set.\n" {
+		t.Errorf("wrong golden file: %q", got)
+	}
+
+	ft = &fakeT{}
+	AssertGolden(ft, v, file)
+	if ft.failed() != "" {
+		t.Error(ft.failed())
+	}
+
+	v.Append("name", "oh noes")
+	ft = &fakeT{}
+	AssertGolden(ft, v, file)
+	if !strings.Contains(ft.failed(), "don't match golden file") {
+		t.Errorf("no error for changed output: %q", ft.failed())
+	}
+}
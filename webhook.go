@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Resolver looks up the IP addresses for a host; *net.Resolver satisfies this
+// interface.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// WebhookOptions are the options for the WebhookURL() validator.
+type WebhookOptions struct {
+	// Allowed schemes; the default is to only allow "https".
+	Schemes []string
+
+	// Allowed ports; the default is to only allow the default port for the
+	// scheme (443 for https, 80 for http).
+	Ports []int
+
+	// Reject IP addresses in these ranges; the default is IPSpecial.
+	Reject IPRange
+
+	// Resolver to look up the IP addresses for the host; the default is
+	// net.DefaultResolver.
+	Resolver Resolver
+}
+
+var defaultPorts = map[string]string{"http": "80", "https": "443"}
+
+// WebhookURL validates that the string is a URL which is safe to send requests
+// to from the server, to prevent server-side request forgery (SSRF).
+//
+// The URL must be absolute, use one of the allowed schemes and ports, and may
+// not contain a username or password. The host must be a domain as accepted by
+// Domain() or an IP address, and IP addresses in the rejected ranges (all
+// loopback, private, link-local, etc. addresses by default) are not allowed.
+// Hosts that look like numeric IPv4 addresses ("2130706433" or "0x7f.1") are
+// not accepted, since some resolvers interpret them as an IP address.
+//
+// Domains are resolved with the Resolver, and the URL is rejected if the host
+// doesn't resolve or if any of the addresses are in the rejected ranges. Note
+// that this doesn't protect against DNS rebinding; the HTTP client that sends
+// the request should check the address it connects to as well.
+func (v *Validator) WebhookURL(ctx context.Context, key, value string, opts WebhookOptions, message ...string) *url.URL {
+	if value == "" {
+		return nil
+	}
+
+	msg := getMessage(message, "")
+	appendMsg := func(m string) *url.URL {
+		if msg != "" {
+			m = msg
+		}
+		v.Append(key, m)
+		return nil
+	}
+
+	if opts.Schemes == nil {
+		opts.Schemes = []string{"https"}
+	}
+	if opts.Reject == 0 {
+		opts.Reject = IPSpecial
+	}
+	if opts.Resolver == nil {
+		opts.Resolver = net.DefaultResolver
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return appendMsg(MessageURL)
+	}
+	if !includeString(opts.Schemes, u.Scheme) {
+		return appendMsg(fmt.Sprintf(MessageURLScheme, strings.Join(opts.Schemes, ", ")))
+	}
+	if u.User != nil {
+		return appendMsg(MessageURLUserinfo)
+	}
+	if !webhookPort(u, opts.Ports) {
+		return appendMsg(fmt.Sprintf(MessageURLPort, u.Port()))
+	}
+
+	host := u.Hostname()
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if addr.Zone() != "" {
+			return appendMsg(MessageURL)
+		}
+		if ClassifyIP(addr)&opts.Reject != 0 {
+			return appendMsg(MessageURLInternal)
+		}
+		return u
+	}
+
+	if !validDomain(host) || looksNumeric(host) {
+		return appendMsg(MessageURL)
+	}
+
+	addrs, err := opts.Resolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return appendMsg(MessageURLResolve)
+	}
+	for _, a := range addrs {
+		addr, ok := netip.AddrFromSlice(a.IP)
+		if !ok || ClassifyIP(addr)&opts.Reject != 0 {
+			return appendMsg(MessageURLInternal)
+		}
+	}
+
+	return u
+}
+
+func webhookPort(u *url.URL, ports []int) bool {
+	port := u.Port()
+	if port == "" {
+		return true
+	}
+	if len(ports) == 0 {
+		return port == defaultPorts[u.Scheme]
+	}
+
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return false
+	}
+	for _, pp := range ports {
+		if p == pp {
+			return true
+		}
+	}
+	return false
+}
+
+// looksNumeric reports if the last label of the host is a number, which the
+// WHATWG URL standard and some resolvers interpret as an IPv4 address.
+func looksNumeric(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	last := strings.ToLower(host[strings.LastIndexByte(host, '.')+1:])
+	if strings.HasPrefix(last, "0x") {
+		return true
+	}
+	_, err := strconv.ParseUint(last, 10, 64)
+	return err == nil
+}
+
+func includeString(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}